@@ -0,0 +1,217 @@
+package sleeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+const defaultADPTeams = 12
+
+// ADPOptions holds options for an ADPAggregator.
+type ADPOptions struct {
+	Teams int // League size that pick numbers are normalised to (default: 12)
+}
+
+// ADPFilter restricts which drafts contribute to ADP results. Zero values match every draft.
+type ADPFilter struct {
+	From        time.Time // Drafts starting at or after this time
+	To          time.Time // Drafts starting before this time
+	ScoringType string    // Draft scoring type, e.g. "ppr", "half_ppr", "dynasty_ppr"
+	Superflex   *bool     // Only superflex (or only non-superflex) drafts
+	Teams       int       // Only drafts with this many teams
+	MinCount    int       // Only players picked in at least this many drafts
+}
+
+// ADPResult holds the average draft position of a single player.
+type ADPResult struct {
+	PlayerID string  `json:"player_id"`
+	Name     string  `json:"name,omitempty"`
+	Position string  `json:"position,omitempty"`
+	ADP      float64 `json:"adp"`
+	MinPick  float64 `json:"min_pick"`
+	MaxPick  float64 `json:"max_pick"`
+	StdDev   float64 `json:"std_dev"`
+	Count    int     `json:"count"` // number of drafts the player was picked in
+}
+
+// ADPAggregator ingests many drafts and computes average draft position per player.
+// Pick numbers are normalised to a reference league size so drafts of different sizes can be combined.
+type ADPAggregator struct {
+	teams  int
+	drafts map[string]*adpDraft
+}
+
+type adpDraft struct {
+	draft *Draft
+	picks []*DraftPick
+}
+
+// NewADPAggregator creates an empty ADPAggregator.
+func NewADPAggregator(options ADPOptions) (*ADPAggregator, error) {
+	if options.Teams < 0 {
+		return nil, errors.New("Teams must be greater than or equal to zero")
+	}
+	if options.Teams == 0 {
+		options.Teams = defaultADPTeams
+	}
+
+	return &ADPAggregator{
+		teams:  options.Teams,
+		drafts: make(map[string]*adpDraft),
+	}, nil
+}
+
+// Add ingests a draft and its picks. Adding the same draft twice replaces the earlier picks.
+// Auction drafts are rejected as their pick order does not reflect player value.
+func (a *ADPAggregator) Add(draft *Draft, picks []*DraftPick) error {
+	if draft == nil {
+		return errors.New("draft is required")
+	}
+	if draft.Type == draftTypeAuction {
+		return fmt.Errorf("draft %s is an auction draft", draft.DraftID)
+	}
+	if draft.Settings == nil || draft.Settings.Teams < 1 {
+		return fmt.Errorf("draft %s has no teams configured", draft.DraftID)
+	}
+
+	a.drafts[draft.DraftID] = &adpDraft{draft: draft, picks: picks}
+	return nil
+}
+
+// Len returns the number of drafts ingested.
+func (a *ADPAggregator) Len() int {
+	return len(a.drafts)
+}
+
+// Load fetches the picks for every completed, non-auction draft that has not already been added
+// (see GetUserDrafts and GetLeagueDrafts) and ingests them.
+func (a *ADPAggregator) Load(ctx context.Context, c *Client, drafts []*Draft) error {
+	for _, draft := range drafts {
+		if draft == nil || draft.Status != draftStatusComplete || draft.Type == draftTypeAuction {
+			continue
+		}
+		if _, ok := a.drafts[draft.DraftID]; ok {
+			continue
+		}
+
+		picks, err := c.GetDraftPicks(ctx, draft.DraftID)
+		if err != nil {
+			return fmt.Errorf("loading adp draft %s: %w", draft.DraftID, err)
+		}
+		if err := a.Add(draft, picks); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Results computes ADP for every player picked in the drafts matching the filter, sorted by ADP.
+func (a *ADPAggregator) Results(filter ADPFilter) []*ADPResult {
+	type sample struct {
+		result *ADPResult
+		picks  []float64
+	}
+	samples := make(map[string]*sample)
+
+	for _, d := range a.drafts {
+		if !filter.matches(d.draft) {
+			continue
+		}
+
+		teams := float64(d.draft.Settings.Teams)
+		for _, pick := range d.picks {
+			if pick == nil || pick.PlayerID == "" || pick.PickNo < 1 || pick.IsKeeper {
+				continue
+			}
+
+			s, ok := samples[pick.PlayerID]
+			if !ok {
+				s = &sample{result: &ADPResult{PlayerID: pick.PlayerID}}
+				samples[pick.PlayerID] = s
+			}
+			if pick.Metadata != nil && s.result.Name == "" {
+				s.result.Name = pick.Metadata.FirstName + " " + pick.Metadata.LastName
+				s.result.Position = pick.Metadata.Position
+			}
+
+			// Scale the pick's relative position in the draft to the reference league size.
+			normalised := float64(pick.PickNo-1)/teams*float64(a.teams) + 1
+			s.picks = append(s.picks, normalised)
+		}
+	}
+
+	results := make([]*ADPResult, 0, len(samples))
+	for _, s := range samples {
+		if len(s.picks) < filter.MinCount {
+			continue
+		}
+
+		r := s.result
+		r.Count = len(s.picks)
+		r.MinPick, r.MaxPick = s.picks[0], s.picks[0]
+		sum := 0.0
+		for _, p := range s.picks {
+			sum += p
+			r.MinPick = math.Min(r.MinPick, p)
+			r.MaxPick = math.Max(r.MaxPick, p)
+		}
+		r.ADP = sum / float64(r.Count)
+
+		variance := 0.0
+		for _, p := range s.picks {
+			variance += (p - r.ADP) * (p - r.ADP)
+		}
+		r.StdDev = math.Sqrt(variance / float64(r.Count))
+
+		results = append(results, r)
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].ADP != results[j].ADP {
+			return results[i].ADP < results[j].ADP
+		}
+		return results[i].PlayerID < results[j].PlayerID
+	})
+	return results
+}
+
+// matches reports whether a draft satisfies the filter.
+func (f ADPFilter) matches(draft *Draft) bool {
+	if f.ScoringType != "" && (draft.Metadata == nil || draft.Metadata.ScoringType != f.ScoringType) {
+		return false
+	}
+	if f.Superflex != nil && (draft.Settings.SlotsSuperFlex > 0) != *f.Superflex {
+		return false
+	}
+	if f.Teams > 0 && draft.Settings.Teams.Int() != f.Teams {
+		return false
+	}
+
+	if f.From.IsZero() && f.To.IsZero() {
+		return true
+	}
+	started := draftStartTime(draft)
+	if started.IsZero() {
+		return false
+	}
+	if !f.From.IsZero() && started.Before(f.From) {
+		return false
+	}
+	if !f.To.IsZero() && !started.Before(f.To) {
+		return false
+	}
+	return true
+}
+
+// draftStartTime returns when a draft started, falling back to when it was created.
+func draftStartTime(draft *Draft) time.Time {
+	if !draft.StartTime.IsZero() {
+		return draft.StartTime.Time()
+	}
+	return draft.Created.Time()
+}
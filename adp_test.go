@@ -0,0 +1,116 @@
+package sleeper
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestADP_Results(t *testing.T) {
+	aggregator, err := NewADPAggregator(ADPOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	start := time.Date(2025, time.August, 1, 0, 0, 0, 0, time.UTC)
+	drafts := []struct {
+		draft *Draft
+		picks []*DraftPick
+	}{
+		{
+			// 12 team ppr: player A 1st, player B 2nd
+			&Draft{DraftID: "d1", Type: "snake", Created: NewTimestamp(start), Metadata: &DraftMetadata{ScoringType: "ppr"}, Settings: &DraftSettings{Teams: 12}},
+			[]*DraftPick{{PickNo: 1, PlayerID: "A"}, {PickNo: 2, PlayerID: "B"}},
+		},
+		{
+			// 6 team ppr: player A 2nd (normalised to pick 3), player B 1st
+			&Draft{DraftID: "d2", Type: "snake", Created: NewTimestamp(start.AddDate(0, 0, 10)), Metadata: &DraftMetadata{ScoringType: "ppr"}, Settings: &DraftSettings{Teams: 6}},
+			[]*DraftPick{{PickNo: 1, PlayerID: "B"}, {PickNo: 2, PlayerID: "A"}},
+		},
+		{
+			// 12 team superflex half ppr: player C 1st
+			&Draft{DraftID: "d3", Type: "snake", Created: NewTimestamp(start.AddDate(0, 0, 20)), Metadata: &DraftMetadata{ScoringType: "half_ppr"}, Settings: &DraftSettings{Teams: 12, SlotsSuperFlex: 1}},
+			[]*DraftPick{{PickNo: 1, PlayerID: "C"}, {PickNo: 2, PlayerID: "A"}},
+		},
+	}
+	for _, d := range drafts {
+		if err := aggregator.Add(d.draft, d.picks); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if err := aggregator.Add(&Draft{DraftID: "auction", Type: "auction", Settings: &DraftSettings{Teams: 12}}, nil); err == nil {
+		t.Errorf("expected auction draft to be rejected")
+	}
+
+	superflex := true
+	tt := []struct {
+		testcase      string
+		filter        ADPFilter
+		expectedFirst string
+		expectedADP   float64
+		expectedCount int
+	}{
+		{
+			"all drafts",
+			ADPFilter{},
+			"C",
+			1,
+			3,
+		},
+		{
+			"ppr only",
+			ADPFilter{ScoringType: "ppr", MinCount: 2},
+			"B",
+			1.5,
+			2,
+		},
+		{
+			"superflex only",
+			ADPFilter{Superflex: &superflex},
+			"C",
+			1,
+			2,
+		},
+		{
+			"date range",
+			ADPFilter{From: start.AddDate(0, 0, 5), To: start.AddDate(0, 0, 15)},
+			"B",
+			1,
+			2,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testcase, func(t *testing.T) {
+			results := aggregator.Results(tc.filter)
+			if len(results) != tc.expectedCount {
+				t.Errorf("expected %d players, got %d", tc.expectedCount, len(results))
+				return
+			}
+
+			first := results[0]
+			if first.PlayerID != tc.expectedFirst {
+				t.Errorf("expected %s to have the best ADP, got %s", tc.expectedFirst, first.PlayerID)
+				return
+			}
+			if math.Abs(first.ADP-tc.expectedADP) > 1e-9 {
+				t.Errorf("expected ADP %v, got %v", tc.expectedADP, first.ADP)
+				return
+			}
+		})
+	}
+
+	// Player A: picks 1, 3 (normalised) and 2.
+	for _, r := range aggregator.Results(ADPFilter{}) {
+		if r.PlayerID != "A" {
+			continue
+		}
+		if r.ADP != 2 || r.MinPick != 1 || r.MaxPick != 3 || r.Count != 3 {
+			t.Errorf("unexpected ADP result for A: %+v", r)
+		}
+		if math.Abs(r.StdDev-math.Sqrt(2.0/3.0)) > 1e-9 {
+			t.Errorf("expected std dev %v, got %v", math.Sqrt(2.0/3.0), r.StdDev)
+		}
+	}
+}
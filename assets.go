@@ -0,0 +1,275 @@
+package sleeper
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+const (
+	contentBaseURL = "https://sleepercdn.com/content"
+	imagesBaseURL  = "https://sleepercdn.com/images"
+
+	assetObjectsDir = "objects"
+	assetRefsDir    = "refs"
+
+	positionDefense = "DEF"
+)
+
+// ErrAssetNotFound is returned when none of an asset's URLs or fallbacks exist on the CDN.
+var ErrAssetNotFound = errors.New("asset not found")
+
+// PlayerHeadshotURL returns the CDN URL of a player's headshot.
+func PlayerHeadshotURL(sport sport, playerID string) string {
+	return fmt.Sprintf("%s/%s/players/%s.jpg", contentBaseURL, sport, url.PathEscape(strings.TrimSpace(playerID)))
+}
+
+// PlayerHeadshotThumbnailURL returns the CDN URL of a player's headshot thumbnail.
+func PlayerHeadshotThumbnailURL(sport sport, playerID string) string {
+	return fmt.Sprintf("%s/%s/players/thumb/%s.jpg", contentBaseURL, sport, url.PathEscape(strings.TrimSpace(playerID)))
+}
+
+// TeamLogoURL returns the CDN URL of a team's logo. team is an abbreviation as in Player.Team.
+func TeamLogoURL(sport sport, team string) string {
+	return fmt.Sprintf("%s/team_logos/%s/%s.png", imagesBaseURL, sport, url.PathEscape(strings.ToLower(strings.TrimSpace(team))))
+}
+
+// HeadshotURL returns the URL of the player's headshot. Team defenses, whose player ID is the team
+// abbreviation, use the team logo.
+func (p *Player) HeadshotURL() string {
+	s := sport(p.Sport)
+	if s == "" {
+		s = SportNFL
+	}
+	if p.Position == positionDefense {
+		return TeamLogoURL(s, p.PlayerID)
+	}
+	return PlayerHeadshotURL(s, p.PlayerID)
+}
+
+// GetPlayerHeadshot downloads a player's headshot and returns the raw bytes.
+func (c *Client) GetPlayerHeadshot(ctx context.Context, sport sport, playerID string) ([]byte, error) {
+	var errs []string
+	playerID = strings.TrimSpace(playerID)
+	if sport == "" {
+		errs = append(errs, "sport is required")
+	}
+	if playerID == "" {
+		errs = append(errs, "playerID is required")
+	}
+	if len(errs) > 0 {
+		return nil, errors.New(strings.Join(errs, "\n"))
+	}
+
+	return c.getRequest(ctx, PlayerHeadshotURL(sport, playerID))
+}
+
+// GetTeamLogo downloads a team's logo and returns the raw bytes.
+func (c *Client) GetTeamLogo(ctx context.Context, sport sport, team string) ([]byte, error) {
+	var errs []string
+	team = strings.TrimSpace(team)
+	if sport == "" {
+		errs = append(errs, "sport is required")
+	}
+	if team == "" {
+		errs = append(errs, "team is required")
+	}
+	if len(errs) > 0 {
+		return nil, errors.New(strings.Join(errs, "\n"))
+	}
+
+	return c.getRequest(ctx, TeamLogoURL(sport, team))
+}
+
+// Asset is an image stored in an AssetCache.
+type Asset struct {
+	URL         string `json:"url"`          // URL the image was downloaded from
+	Hash        string `json:"hash"`         // SHA-256 of the image data
+	Path        string `json:"path"`         // location of the image in the cache
+	ContentType string `json:"content_type"` // sniffed from the data
+	Data        []byte `json:"-"`
+}
+
+// AssetCache is a content-addressed on-disk cache of CDN images. Images are stored once per distinct
+// content under objects/, and each URL is mapped to its content hash under refs/, so placeholder images
+// served for many URLs take no extra space. Downloads go through the client's rate limiter.
+type AssetCache struct {
+	client *Client
+	dir    string
+
+	mu      sync.Mutex
+	missing map[string]bool // URLs the CDN has no image for
+}
+
+// NewAssetCache creates an asset cache in dir, creating the directory if needed.
+func NewAssetCache(client *Client, dir string) (*AssetCache, error) {
+	var errs []string
+	if client == nil {
+		errs = append(errs, "client is required")
+	}
+	if strings.TrimSpace(dir) == "" {
+		errs = append(errs, "dir is required")
+	}
+	if len(errs) > 0 {
+		return nil, errors.New(strings.Join(errs, "\n"))
+	}
+
+	for _, sub := range []string{assetObjectsDir, assetRefsDir} {
+		if err := os.MkdirAll(filepath.Join(dir, sub), 0o755); err != nil {
+			return nil, fmt.Errorf("creating asset cache: %w", err)
+		}
+	}
+
+	return &AssetCache{
+		client:  client,
+		dir:     dir,
+		missing: make(map[string]bool),
+	}, nil
+}
+
+// Get returns the first of the URLs that has an image, reading it from the cache or downloading and
+// storing it. Later URLs are fallbacks tried when the CDN returns 404 for earlier ones. ErrAssetNotFound
+// is returned if none exist.
+func (a *AssetCache) Get(ctx context.Context, urls ...string) (*Asset, error) {
+	for _, u := range urls {
+		if u == "" {
+			continue
+		}
+
+		if asset, err := a.cached(u); err == nil {
+			return asset, nil
+		}
+
+		a.mu.Lock()
+		missing := a.missing[u]
+		a.mu.Unlock()
+		if missing {
+			continue
+		}
+
+		data, err := a.client.getRequest(ctx, u)
+		if err != nil {
+			var apiErr *APIError
+			if errors.As(err, &apiErr) && (apiErr.StatusCode == http.StatusNotFound || apiErr.StatusCode == http.StatusForbidden) {
+				a.mu.Lock()
+				a.missing[u] = true
+				a.mu.Unlock()
+				continue
+			}
+			return nil, fmt.Errorf("getting asset: %w", err)
+		}
+
+		asset, err := a.store(u, data)
+		if err != nil {
+			return nil, fmt.Errorf("storing asset: %w", err)
+		}
+		return asset, nil
+	}
+
+	return nil, ErrAssetNotFound
+}
+
+// PlayerHeadshot returns a player's headshot, falling back to the thumbnail and then the logo of the
+// player's team.
+func (a *AssetCache) PlayerHeadshot(ctx context.Context, player *Player) (*Asset, error) {
+	if player == nil || strings.TrimSpace(player.PlayerID) == "" {
+		return nil, errors.New("player is required")
+	}
+
+	s := sport(player.Sport)
+	if s == "" {
+		s = SportNFL
+	}
+	urls := []string{player.HeadshotURL(), PlayerHeadshotThumbnailURL(s, player.PlayerID)}
+	if player.Team != nil && *player.Team != "" {
+		urls = append(urls, TeamLogoURL(s, *player.Team))
+	}
+
+	return a.Get(ctx, urls...)
+}
+
+// TeamLogo returns a team's logo.
+func (a *AssetCache) TeamLogo(ctx context.Context, sport sport, team string) (*Asset, error) {
+	if strings.TrimSpace(team) == "" {
+		return nil, errors.New("team is required")
+	}
+
+	return a.Get(ctx, TeamLogoURL(sport, team))
+}
+
+// cached reads the asset stored for a URL.
+func (a *AssetCache) cached(u string) (*Asset, error) {
+	ref, err := os.ReadFile(a.refPath(u))
+	if err != nil {
+		return nil, err
+	}
+
+	hash := strings.TrimSpace(string(ref))
+	path := a.objectPath(hash)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Asset{URL: u, Hash: hash, Path: path, ContentType: http.DetectContentType(data), Data: data}, nil
+}
+
+// store writes an image under its content hash, if not already present, and maps the URL to it.
+func (a *AssetCache) store(u string, data []byte) (*Asset, error) {
+	sum := sha256.Sum256(data)
+	hash := hex.EncodeToString(sum[:])
+	path := a.objectPath(hash)
+
+	if _, err := os.Stat(path); err != nil {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return nil, err
+		}
+		if err := writeFileAtomic(path, data); err != nil {
+			return nil, err
+		}
+	}
+	if err := writeFileAtomic(a.refPath(u), []byte(hash)); err != nil {
+		return nil, err
+	}
+
+	return &Asset{URL: u, Hash: hash, Path: path, ContentType: http.DetectContentType(data), Data: data}, nil
+}
+
+func (a *AssetCache) objectPath(hash string) string {
+	if len(hash) < 2 {
+		return filepath.Join(a.dir, assetObjectsDir, hash)
+	}
+	return filepath.Join(a.dir, assetObjectsDir, hash[:2], hash)
+}
+
+func (a *AssetCache) refPath(u string) string {
+	sum := sha256.Sum256([]byte(u))
+	return filepath.Join(a.dir, assetRefsDir, hex.EncodeToString(sum[:]))
+}
+
+// writeFileAtomic writes data to a temporary file beside path and renames it into place, so readers
+// never see a partial file.
+func writeFileAtomic(path string, data []byte) error {
+	f, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
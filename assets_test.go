@@ -0,0 +1,187 @@
+package sleeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"golang.org/x/time/rate"
+)
+
+// cdnTransport serves fixed responses by URL and counts requests.
+type cdnTransport struct {
+	mu        sync.Mutex
+	responses map[string][]byte
+	requests  map[string]int
+}
+
+func (t *cdnTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.requests[req.URL.String()]++
+	body, ok := t.responses[req.URL.String()]
+	status := http.StatusOK
+	if !ok {
+		status = http.StatusNotFound
+	}
+	return &http.Response{
+		StatusCode: status,
+		Header:     make(http.Header),
+		Body:       io.NopCloser(bytes.NewReader(body)),
+		Request:    req,
+	}, nil
+}
+
+func newCDNClient(responses map[string][]byte) (*Client, *cdnTransport) {
+	transport := &cdnTransport{responses: responses, requests: make(map[string]int)}
+	return &Client{
+		client:      &http.Client{Transport: transport},
+		rateLimiter: rate.NewLimiter(rate.Inf, 1),
+	}, transport
+}
+
+func TestAssets_URL(t *testing.T) {
+	tt := []struct {
+		testcase    string
+		player      *Player
+		expectedURL string
+	}{
+		{
+			"nfl player",
+			&Player{PlayerID: "4046", Sport: "nfl", Position: "QB"},
+			"https://sleepercdn.com/content/nfl/players/4046.jpg",
+		},
+		{
+			"nba player",
+			&Player{PlayerID: "1308", Sport: "nba", Position: "PG"},
+			"https://sleepercdn.com/content/nba/players/1308.jpg",
+		},
+		{
+			"team defense",
+			&Player{PlayerID: "KC", Position: "DEF"},
+			"https://sleepercdn.com/images/team_logos/nfl/kc.png",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testcase, func(t *testing.T) {
+			if url := tc.player.HeadshotURL(); url != tc.expectedURL {
+				t.Errorf("expected URL %s, got %s", tc.expectedURL, url)
+			}
+		})
+	}
+}
+
+func TestAssets_Cache(t *testing.T) {
+	logo := []byte("\x89PNG\r\n\x1a\nlogo")
+	headshot := []byte("\xff\xd8\xffheadshot")
+	client, transport := newCDNClient(map[string][]byte{
+		PlayerHeadshotURL(SportNFL, "4046"): headshot,
+		TeamLogoURL(SportNFL, "KC"):         logo,
+		TeamLogoURL(SportNFL, "BUF"):        logo,
+	})
+
+	dir := t.TempDir()
+	cache, err := NewAssetCache(client, dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ctx := context.Background()
+
+	tt := []struct {
+		testcase     string
+		player       *Player
+		expectedURL  string
+		expectedType string
+		shouldPass   bool
+	}{
+		{
+			"headshot",
+			&Player{PlayerID: "4046", Sport: "nfl", Team: strPtr("KC")},
+			PlayerHeadshotURL(SportNFL, "4046"),
+			"image/jpeg",
+			true,
+		},
+		{
+			"missing headshot falls back to team logo",
+			&Player{PlayerID: "9999", Sport: "nfl", Team: strPtr("KC")},
+			TeamLogoURL(SportNFL, "KC"),
+			"image/png",
+			true,
+		},
+		{
+			"missing headshot without a team",
+			&Player{PlayerID: "9998", Sport: "nfl"},
+			"",
+			"",
+			false,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testcase, func(t *testing.T) {
+			asset, err := cache.PlayerHeadshot(ctx, tc.player)
+			if err != nil {
+				if tc.shouldPass {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+				if !errors.Is(err, ErrAssetNotFound) {
+					t.Errorf("expected ErrAssetNotFound, got %v", err)
+				}
+				t.Logf("expected error: %v", err)
+				return
+			}
+
+			if !tc.shouldPass {
+				t.Errorf("expected failure but got asset: %+v", asset)
+				return
+			}
+
+			if asset.URL != tc.expectedURL || asset.ContentType != tc.expectedType {
+				t.Errorf("unexpected asset: %+v", asset)
+				return
+			}
+			if data, err := os.ReadFile(asset.Path); err != nil || !bytes.Equal(data, asset.Data) {
+				t.Errorf("expected asset to be stored at %s: %v", asset.Path, err)
+			}
+		})
+	}
+
+	// Identical images are stored once.
+	buf, err := cache.TeamLogo(ctx, SportNFL, "BUF")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	kc, err := cache.TeamLogo(ctx, SportNFL, "kc")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if buf.Path != kc.Path {
+		t.Errorf("expected identical logos to share %s, got %s", kc.Path, buf.Path)
+	}
+	objects, _ := filepath.Glob(filepath.Join(dir, assetObjectsDir, "*", "*"))
+	if len(objects) != 2 {
+		t.Errorf("expected 2 stored objects, got %d", len(objects))
+	}
+
+	// Cached and known-missing URLs are not requested again.
+	if _, err := cache.PlayerHeadshot(ctx, &Player{PlayerID: "9999", Sport: "nfl", Team: strPtr("KC")}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for u, n := range transport.requests {
+		if n != 1 {
+			t.Errorf("expected 1 request for %s, got %d", u, n)
+		}
+	}
+	if !strings.HasPrefix(kc.URL, imagesBaseURL) {
+		t.Errorf("unexpected logo URL: %s", kc.URL)
+	}
+}
@@ -0,0 +1,320 @@
+package sleeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+const defaultAuctionBudget = 200 // Sleeper's default auction budget
+
+// AuctionAmount returns the price paid for a pick in an auction draft, or zero if the pick has no price.
+func (p *DraftPick) AuctionAmount() int {
+	if p == nil || p.Metadata == nil {
+		return 0
+	}
+	return p.Metadata.Amount.Int()
+}
+
+// AuctionRosterSpend summarises a roster's spending in an auction draft.
+type AuctionRosterSpend struct {
+	RosterID  int `json:"roster_id"`
+	Players   int `json:"players"`
+	Spent     int `json:"spent"`
+	Remaining int `json:"remaining"`
+	MaxPrice  int `json:"max_price"`
+}
+
+// AuctionBudgetPoint records a roster's remaining budget immediately after one of its picks.
+type AuctionBudgetPoint struct {
+	PickNo    int    `json:"pick_no"`
+	RosterID  int    `json:"roster_id"`
+	PlayerID  string `json:"player_id"`
+	Amount    int    `json:"amount"`
+	Remaining int    `json:"remaining"`
+}
+
+// AuctionPositionPrice summarises the prices paid for a position.
+type AuctionPositionPrice struct {
+	Position string  `json:"position"`
+	Players  int     `json:"players"`
+	Total    int     `json:"total"`
+	Average  float64 `json:"average"`
+	Max      int     `json:"max"`
+}
+
+// AuctionSummary holds spending analytics for a single auction draft.
+type AuctionSummary struct {
+	DraftID   string                  `json:"draft_id"`
+	Budget    int                     `json:"budget"`
+	Rosters   []*AuctionRosterSpend   `json:"rosters"`   // sorted by roster_id
+	Timeline  []*AuctionBudgetPoint   `json:"timeline"`  // remaining budget over time, sorted by pick_no
+	Positions []*AuctionPositionPrice `json:"positions"` // sorted by total spend, highest first
+}
+
+// Roster returns the spend summary for a roster, or nil.
+func (s *AuctionSummary) Roster(rosterID int) *AuctionRosterSpend {
+	for _, r := range s.Rosters {
+		if r.RosterID == rosterID {
+			return r
+		}
+	}
+	return nil
+}
+
+// NewAuctionSummary computes spend per roster, remaining budget over time and price by position for an auction draft.
+func NewAuctionSummary(draft *Draft, picks []*DraftPick) (*AuctionSummary, error) {
+	if draft == nil {
+		return nil, errors.New("draft is required")
+	}
+	if draft.Type != draftTypeAuction {
+		return nil, fmt.Errorf("draft %s is not an auction draft", draft.DraftID)
+	}
+
+	summary := &AuctionSummary{
+		DraftID: draft.DraftID,
+		Budget:  auctionBudget(draft),
+	}
+
+	rosters := make(map[int]*AuctionRosterSpend)
+	for _, rosterID := range draft.SlotToRosterID {
+		rosters[rosterID] = &AuctionRosterSpend{RosterID: rosterID, Remaining: summary.Budget}
+	}
+
+	sorted := sortedPicks(picks)
+	positions := make(map[string]*AuctionPositionPrice)
+	for _, pick := range sorted {
+		amount := pick.AuctionAmount()
+
+		r, ok := rosters[pick.RosterID]
+		if !ok {
+			r = &AuctionRosterSpend{RosterID: pick.RosterID, Remaining: summary.Budget}
+			rosters[pick.RosterID] = r
+		}
+		r.Players++
+		r.Spent += amount
+		r.Remaining -= amount
+		if amount > r.MaxPrice {
+			r.MaxPrice = amount
+		}
+
+		summary.Timeline = append(summary.Timeline, &AuctionBudgetPoint{
+			PickNo:    pick.PickNo,
+			RosterID:  pick.RosterID,
+			PlayerID:  pick.PlayerID,
+			Amount:    amount,
+			Remaining: r.Remaining,
+		})
+
+		position := ""
+		if pick.Metadata != nil {
+			position = pick.Metadata.Position
+		}
+		p, ok := positions[position]
+		if !ok {
+			p = &AuctionPositionPrice{Position: position}
+			positions[position] = p
+		}
+		p.Players++
+		p.Total += amount
+		if amount > p.Max {
+			p.Max = amount
+		}
+	}
+
+	for _, r := range rosters {
+		summary.Rosters = append(summary.Rosters, r)
+	}
+	sort.Slice(summary.Rosters, func(i, j int) bool {
+		return summary.Rosters[i].RosterID < summary.Rosters[j].RosterID
+	})
+
+	for _, p := range positions {
+		p.Average = float64(p.Total) / float64(p.Players)
+		summary.Positions = append(summary.Positions, p)
+	}
+	sort.Slice(summary.Positions, func(i, j int) bool {
+		if summary.Positions[i].Total != summary.Positions[j].Total {
+			return summary.Positions[i].Total > summary.Positions[j].Total
+		}
+		return summary.Positions[i].Position < summary.Positions[j].Position
+	})
+
+	return summary, nil
+}
+
+// GetAuctionSummary retrieves an auction draft and its picks and computes its spending analytics.
+func (c *Client) GetAuctionSummary(ctx context.Context, draftID string) (*AuctionSummary, error) {
+	draft, err := c.GetDraft(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("getting auction summary: %w", err)
+	}
+
+	picks, err := c.GetDraftPicks(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("getting auction summary: %w", err)
+	}
+
+	return NewAuctionSummary(draft, picks)
+}
+
+// AuctionValueOptions holds options for an AuctionValueAggregator.
+type AuctionValueOptions struct {
+	Budget int // Budget that prices are normalised to (default: 200)
+}
+
+// AuctionValue holds the average auction value of a single player across many drafts.
+type AuctionValue struct {
+	PlayerID string  `json:"player_id"`
+	Name     string  `json:"name,omitempty"`
+	Position string  `json:"position,omitempty"`
+	Average  float64 `json:"average"`
+	Min      float64 `json:"min"`
+	Max      float64 `json:"max"`
+	Count    int     `json:"count"`
+}
+
+// AuctionValueAggregator ingests many auction drafts and computes average auction value per player.
+// Prices are normalised to a reference budget so drafts with different budgets can be combined.
+type AuctionValueAggregator struct {
+	budget int
+	drafts map[string]*adpDraft
+}
+
+// NewAuctionValueAggregator creates an empty AuctionValueAggregator.
+func NewAuctionValueAggregator(options AuctionValueOptions) (*AuctionValueAggregator, error) {
+	if options.Budget < 0 {
+		return nil, errors.New("Budget must be greater than or equal to zero")
+	}
+	if options.Budget == 0 {
+		options.Budget = defaultAuctionBudget
+	}
+
+	return &AuctionValueAggregator{
+		budget: options.Budget,
+		drafts: make(map[string]*adpDraft),
+	}, nil
+}
+
+// Add ingests an auction draft and its picks. Adding the same draft twice replaces the earlier picks.
+func (a *AuctionValueAggregator) Add(draft *Draft, picks []*DraftPick) error {
+	if draft == nil {
+		return errors.New("draft is required")
+	}
+	if draft.Type != draftTypeAuction {
+		return fmt.Errorf("draft %s is not an auction draft", draft.DraftID)
+	}
+	if draft.Settings == nil {
+		return fmt.Errorf("draft %s has no settings", draft.DraftID)
+	}
+
+	a.drafts[draft.DraftID] = &adpDraft{draft: draft, picks: picks}
+	return nil
+}
+
+// Load fetches the picks for every completed auction draft that has not already been added and ingests them.
+func (a *AuctionValueAggregator) Load(ctx context.Context, c *Client, drafts []*Draft) error {
+	for _, draft := range drafts {
+		if draft == nil || draft.Status != draftStatusComplete || draft.Type != draftTypeAuction {
+			continue
+		}
+		if _, ok := a.drafts[draft.DraftID]; ok {
+			continue
+		}
+
+		picks, err := c.GetDraftPicks(ctx, draft.DraftID)
+		if err != nil {
+			return fmt.Errorf("loading auction draft %s: %w", draft.DraftID, err)
+		}
+		if err := a.Add(draft, picks); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Results computes the average auction value for every player bought in the drafts matching the filter,
+// sorted by average value, highest first. The filter is applied as it is for ADP results.
+func (a *AuctionValueAggregator) Results(filter ADPFilter) []*AuctionValue {
+	type sample struct {
+		value  *AuctionValue
+		prices []float64
+	}
+	samples := make(map[string]*sample)
+
+	for _, d := range a.drafts {
+		if !filter.matches(d.draft) {
+			continue
+		}
+
+		scale := float64(a.budget) / float64(auctionBudget(d.draft))
+		for _, pick := range d.picks {
+			if pick == nil || pick.PlayerID == "" {
+				continue
+			}
+
+			s, ok := samples[pick.PlayerID]
+			if !ok {
+				s = &sample{value: &AuctionValue{PlayerID: pick.PlayerID}}
+				samples[pick.PlayerID] = s
+			}
+			if pick.Metadata != nil && s.value.Name == "" {
+				s.value.Name = pick.Metadata.FirstName + " " + pick.Metadata.LastName
+				s.value.Position = pick.Metadata.Position
+			}
+			s.prices = append(s.prices, float64(pick.AuctionAmount())*scale)
+		}
+	}
+
+	values := make([]*AuctionValue, 0, len(samples))
+	for _, s := range samples {
+		if len(s.prices) < filter.MinCount {
+			continue
+		}
+
+		v := s.value
+		v.Count = len(s.prices)
+		v.Min, v.Max = s.prices[0], s.prices[0]
+		sum := 0.0
+		for _, p := range s.prices {
+			sum += p
+			v.Min = math.Min(v.Min, p)
+			v.Max = math.Max(v.Max, p)
+		}
+		v.Average = sum / float64(v.Count)
+		values = append(values, v)
+	}
+
+	sort.Slice(values, func(i, j int) bool {
+		if values[i].Average != values[j].Average {
+			return values[i].Average > values[j].Average
+		}
+		return values[i].PlayerID < values[j].PlayerID
+	})
+	return values
+}
+
+// auctionBudget returns the per-roster budget of an auction draft.
+func auctionBudget(draft *Draft) int {
+	if draft.Settings != nil && draft.Settings.Budget > 0 {
+		return draft.Settings.Budget.Int()
+	}
+	return defaultAuctionBudget
+}
+
+// sortedPicks returns the non-nil picks sorted by pick number.
+func sortedPicks(picks []*DraftPick) []*DraftPick {
+	sorted := make([]*DraftPick, 0, len(picks))
+	for _, p := range picks {
+		if p != nil {
+			sorted = append(sorted, p)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].PickNo < sorted[j].PickNo
+	})
+	return sorted
+}
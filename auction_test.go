@@ -0,0 +1,122 @@
+package sleeper
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func testAuctionPicks(t *testing.T) []*DraftPick {
+	t.Helper()
+
+	// Auction amounts are returned as strings in pick metadata.
+	data := `[
+		{"pick_no": 2, "roster_id": 2, "player_id": "B", "metadata": {"amount": "30", "position": "WR"}},
+		{"pick_no": 1, "roster_id": 1, "player_id": "A", "metadata": {"amount": "55", "position": "RB"}},
+		{"pick_no": 3, "roster_id": 1, "player_id": "C", "metadata": {"amount": "10", "position": "WR"}}
+	]`
+
+	var picks []*DraftPick
+	if err := json.Unmarshal([]byte(data), &picks); err != nil {
+		t.Fatalf("unexpected error unmarshaling picks: %v", err)
+	}
+	return picks
+}
+
+func TestAuction_Summary(t *testing.T) {
+	picks := testAuctionPicks(t)
+
+	tt := []struct {
+		testcase       string
+		draft          *Draft
+		expectedBudget int
+		shouldPass     bool
+	}{
+		{
+			"auction draft with budget",
+			&Draft{DraftID: "a1", Type: "auction", SlotToRosterID: map[string]int{"1": 1, "2": 2, "3": 3}, Settings: &DraftSettings{Budget: 100}},
+			100,
+			true,
+		},
+		{
+			"auction draft with default budget",
+			&Draft{DraftID: "a2", Type: "auction", Settings: &DraftSettings{}},
+			200,
+			true,
+		},
+		{
+			"snake draft",
+			&Draft{DraftID: "s1", Type: "snake", Settings: &DraftSettings{}},
+			0,
+			false,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testcase, func(t *testing.T) {
+			summary, err := NewAuctionSummary(tc.draft, picks)
+			if err != nil {
+				if tc.shouldPass {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+				t.Logf("expected error: %v", err)
+				return
+			}
+
+			if !tc.shouldPass {
+				t.Errorf("expected failure but got summary: %v", summary)
+				return
+			}
+
+			if summary.Budget != tc.expectedBudget {
+				t.Errorf("expected budget %d, got %d", tc.expectedBudget, summary.Budget)
+				return
+			}
+
+			r := summary.Roster(1)
+			if r == nil || r.Spent != 65 || r.Remaining != tc.expectedBudget-65 || r.MaxPrice != 55 {
+				t.Errorf("unexpected spend for roster 1: %+v", r)
+				return
+			}
+
+			if len(summary.Timeline) != 3 || summary.Timeline[2].Remaining != tc.expectedBudget-65 {
+				t.Errorf("unexpected budget timeline: %+v", summary.Timeline)
+				return
+			}
+
+			if summary.Positions[0].Position != "RB" || summary.Positions[1].Average != 20 {
+				t.Errorf("unexpected position prices: %+v %+v", summary.Positions[0], summary.Positions[1])
+				return
+			}
+		})
+	}
+}
+
+func TestAuction_Values(t *testing.T) {
+	aggregator, err := NewAuctionValueAggregator(AuctionValueOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	picks := testAuctionPicks(t)
+	if err := aggregator.Add(&Draft{DraftID: "a1", Type: "auction", Settings: &DraftSettings{Budget: 100}}, picks); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := aggregator.Add(&Draft{DraftID: "a2", Type: "auction", Settings: &DraftSettings{Budget: 200}}, picks); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := aggregator.Add(&Draft{DraftID: "s1", Type: "snake", Settings: &DraftSettings{}}, picks); err == nil {
+		t.Errorf("expected snake draft to be rejected")
+	}
+
+	values := aggregator.Results(ADPFilter{})
+	if len(values) != 3 {
+		t.Fatalf("expected 3 players, got %d", len(values))
+	}
+
+	// Player A cost 55 of 100 (110 normalised) and 55 of 200.
+	a := values[0]
+	if a.PlayerID != "A" || a.Average != 82.5 || a.Min != 55 || a.Max != 110 || a.Count != 2 {
+		t.Errorf("unexpected auction value for A: %+v", a)
+	}
+}
@@ -1,35 +1,204 @@
 package sleeper
 
 import (
+	"bytes"
 	"context"
+	"encoding/binary"
 	"errors"
 	"fmt"
+	"image"
+	_ "image/gif"  // register GIF decoding for DecodeImage
+	_ "image/jpeg" // register JPEG decoding for DecodeImage
+	_ "image/png"  // register PNG decoding for DecodeImage
+	"net/http"
+	"net/url"
 	"strings"
 )
 
 const (
+	avatarCDNHost      = "sleepercdn.com"
 	avatarBaseURL      = "https://sleepercdn.com/avatars"
 	avatarThumbnailURL = "https://sleepercdn.com/avatars/thumbs"
 )
 
 // GetAvatarImage downloads the full-size avatar image and returns the raw bytes
 func (c *Client) GetAvatarImage(ctx context.Context, avatarID string) ([]byte, error) {
-	avatarID = strings.TrimSpace(avatarID)
-	if avatarID == "" {
-		return nil, errors.New("avatarID is required")
+	endpoint, err := avatarEndpoint(avatarID, false)
+	if err != nil {
+		return nil, err
 	}
 
-	endpoint := fmt.Sprintf("%s/%s", avatarBaseURL, avatarID)
 	return c.getRequest(ctx, endpoint)
 }
 
 // GetAvatarThumbnail downloads the thumbnail avatar image and returns the raw bytes
 func (c *Client) GetAvatarThumbnail(ctx context.Context, avatarID string) ([]byte, error) {
+	endpoint, err := avatarEndpoint(avatarID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.getRequest(ctx, endpoint)
+}
+
+// avatarEndpoint returns the URL to download an avatar from, which is always on the Sleeper CDN.
+func avatarEndpoint(avatarID string, thumbnail bool) (string, error) {
 	avatarID = strings.TrimSpace(avatarID)
 	if avatarID == "" {
-		return nil, errors.New("avatarID is required")
+		return "", errors.New("avatarID is required")
 	}
 
-	endpoint := fmt.Sprintf("%s/%s", avatarThumbnailURL, avatarID)
-	return c.getRequest(ctx, endpoint)
+	endpoint := AvatarURL(avatarID)
+	if thumbnail {
+		endpoint = AvatarThumbnailURL(avatarID)
+	}
+	if endpoint == "" {
+		return "", fmt.Errorf("avatar is not hosted on %s: %s", avatarCDNHost, avatarID)
+	}
+	return endpoint, nil
+}
+
+// AvatarURL returns the CDN URL of a full-size avatar image. Avatars that are already Sleeper CDN URLs are
+// returned unchanged; URLs on other hosts return an empty string.
+func AvatarURL(avatarID string) string {
+	return avatarURL(avatarBaseURL, avatarID)
+}
+
+// AvatarThumbnailURL returns the CDN URL of an avatar thumbnail. Avatars that are already Sleeper CDN URLs are
+// returned unchanged; URLs on other hosts return an empty string.
+func AvatarThumbnailURL(avatarID string) string {
+	return avatarURL(avatarThumbnailURL, avatarID)
+}
+
+func avatarURL(base, avatarID string) string {
+	avatarID = strings.TrimSpace(avatarID)
+	if avatarID == "" {
+		return ""
+	}
+	if strings.HasPrefix(avatarID, "http://") || strings.HasPrefix(avatarID, "https://") {
+		if !isAvatarCDNURL(avatarID) {
+			return ""
+		}
+		return avatarID
+	}
+	return fmt.Sprintf("%s/%s", base, url.PathEscape(avatarID))
+}
+
+// isAvatarCDNURL reports whether a URL is an HTTPS URL on the Sleeper CDN.
+func isAvatarCDNURL(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Scheme != "https" || u.User != nil {
+		return false
+	}
+	host := strings.ToLower(u.Hostname())
+	return host == avatarCDNHost || strings.HasSuffix(host, "."+avatarCDNHost)
+}
+
+// AvatarURL returns the URL of the user's full-size avatar, or an empty string if they have none.
+func (u *User) AvatarURL() string {
+	return AvatarURL(u.Avatar)
+}
+
+// AvatarThumbnailURL returns the URL of the user's avatar thumbnail, or an empty string if they have none.
+func (u *User) AvatarThumbnailURL() string {
+	return AvatarThumbnailURL(u.Avatar)
+}
+
+// AvatarURL returns the URL of the league's full-size avatar, or an empty string if it has none.
+func (l *League) AvatarURL() string {
+	return AvatarURL(l.Avatar)
+}
+
+// AvatarThumbnailURL returns the URL of the league's avatar thumbnail, or an empty string if it has none.
+func (l *League) AvatarThumbnailURL() string {
+	return AvatarThumbnailURL(l.Avatar)
+}
+
+// ImageInfo describes an image's format and dimensions.
+type ImageInfo struct {
+	ContentType string `json:"content_type"` // sniffed from the data, e.g. "image/png"
+	Format      string `json:"format"`       // "png", "jpeg", "gif" or "webp"
+	Width       int    `json:"width"`
+	Height      int    `json:"height"`
+}
+
+// ErrUnsupportedImage is returned when image data is not in a format that can be decoded.
+var ErrUnsupportedImage = errors.New("unsupported image format")
+
+// DecodeImageInfo sniffs the content type of image data, such as an avatar, and reads its dimensions
+// without decoding the whole image. PNG, JPEG, GIF and WebP are supported.
+func DecodeImageInfo(data []byte) (*ImageInfo, error) {
+	info := &ImageInfo{ContentType: http.DetectContentType(data)}
+
+	if info.ContentType == contentTypeWebP {
+		width, height, err := webpDimensions(data)
+		if err != nil {
+			return nil, err
+		}
+		info.Format, info.Width, info.Height = imageFormatWebP, width, height
+		return info, nil
+	}
+
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedImage, info.ContentType)
+	}
+	info.Format, info.Width, info.Height = format, config.Width, config.Height
+	return info, nil
+}
+
+// DecodeImage decodes image data, such as an avatar, to an image.Image. PNG, JPEG and GIF images are decoded;
+// WebP images are detected and reported by DecodeImageInfo but return ErrUnsupportedImage here.
+func DecodeImage(data []byte) (image.Image, *ImageInfo, error) {
+	info, err := DecodeImageInfo(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	if info.Format == imageFormatWebP {
+		return nil, info, fmt.Errorf("%w: decoding %s", ErrUnsupportedImage, info.ContentType)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, info, fmt.Errorf("decoding image: %w", err)
+	}
+	return img, info, nil
+}
+
+const (
+	contentTypeWebP = "image/webp"
+	imageFormatWebP = "webp"
+)
+
+// webpDimensions reads the canvas size from a WebP file's lossy (VP8), lossless (VP8L) or extended (VP8X) header.
+func webpDimensions(data []byte) (int, int, error) {
+	if len(data) < 30 || string(data[0:4]) != "RIFF" || string(data[8:12]) != "WEBP" {
+		return 0, 0, fmt.Errorf("%w: invalid webp header", ErrUnsupportedImage)
+	}
+
+	chunk := data[20:]
+	switch string(data[12:16]) {
+	case "VP8 ":
+		// Frame tag (3 bytes), start code (3 bytes), then 14-bit width and height.
+		if chunk[3] != 0x9d || chunk[4] != 0x01 || chunk[5] != 0x2a {
+			return 0, 0, fmt.Errorf("%w: invalid vp8 start code", ErrUnsupportedImage)
+		}
+		width := int(binary.LittleEndian.Uint16(chunk[6:8]) & 0x3fff)
+		height := int(binary.LittleEndian.Uint16(chunk[8:10]) & 0x3fff)
+		return width, height, nil
+	case "VP8L":
+		// Signature byte, then 14-bit width-1 and height-1 packed into 4 bytes.
+		if chunk[0] != 0x2f {
+			return 0, 0, fmt.Errorf("%w: invalid vp8l signature", ErrUnsupportedImage)
+		}
+		bits := binary.LittleEndian.Uint32(chunk[1:5])
+		return int(bits&0x3fff) + 1, int((bits>>14)&0x3fff) + 1, nil
+	case "VP8X":
+		// Flags (4 bytes), then 24-bit canvas width-1 and height-1.
+		width := int(uint32(chunk[4])|uint32(chunk[5])<<8|uint32(chunk[6])<<16) + 1
+		height := int(uint32(chunk[7])|uint32(chunk[8])<<8|uint32(chunk[9])<<16) + 1
+		return width, height, nil
+	default:
+		return 0, 0, fmt.Errorf("%w: unknown webp chunk %q", ErrUnsupportedImage, data[12:16])
+	}
 }
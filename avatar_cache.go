@@ -0,0 +1,225 @@
+package sleeper
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultAvatarConcurrency = 4
+	defaultAvatarMaxAge      = 24 * time.Hour
+
+	avatarThumbnailDir = "thumbs"
+	avatarMetaSuffix   = ".json"
+)
+
+// avatarIDPattern matches avatar IDs that are safe to use as file names.
+var avatarIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// AvatarFetchOptions holds options for FetchAvatars.
+type AvatarFetchOptions struct {
+	Dir         string        // Directory avatars are stored in, keyed by avatar ID (required)
+	Thumbnails  bool          // Fetch thumbnails instead of full-size avatars, stored under thumbs/
+	Concurrency int           // Maximum concurrent downloads (default: 4); the client's rate limit still applies
+	MaxAge      time.Duration // Cached avatars younger than this are used without revalidation (default: 24h)
+}
+
+func (o *AvatarFetchOptions) validate() error {
+	var errs []string
+	if strings.TrimSpace(o.Dir) == "" {
+		errs = append(errs, "Dir is required")
+	}
+	if o.Concurrency < 0 {
+		errs = append(errs, "Concurrency must be greater than or equal to zero")
+	}
+	if o.MaxAge < 0 {
+		errs = append(errs, "MaxAge must be greater than or equal to zero")
+	}
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+// AvatarFile is an avatar stored on disk by FetchAvatars.
+type AvatarFile struct {
+	AvatarID    string `json:"avatar_id"`
+	Path        string `json:"path"`
+	Data        []byte `json:"-"`
+	Downloaded  bool   `json:"downloaded"`  // the avatar was downloaded, rather than read from the cache
+	Revalidated bool   `json:"revalidated"` // the cached avatar was confirmed unchanged by the CDN
+}
+
+// avatarMeta holds the validators used to revalidate a cached avatar.
+type avatarMeta struct {
+	ETag         string    `json:"etag,omitempty"`
+	LastModified string    `json:"last_modified,omitempty"`
+	CheckedAt    time.Time `json:"checked_at"`
+}
+
+// FetchAvatars downloads avatars concurrently into a local directory keyed by avatar ID. Cached avatars
+// older than MaxAge are revalidated with If-None-Match and If-Modified-Since, so unchanged avatars are not
+// downloaded again. Duplicate and empty IDs are ignored. Avatars that fail are left out of the result and
+// reported in the returned error.
+func (c *Client) FetchAvatars(ctx context.Context, avatarIDs []string, options AvatarFetchOptions) (map[string]*AvatarFile, error) {
+	if err := options.validate(); err != nil {
+		return nil, fmt.Errorf("invalid options: %w", err)
+	}
+	if options.Concurrency == 0 {
+		options.Concurrency = defaultAvatarConcurrency
+	}
+	if options.MaxAge == 0 {
+		options.MaxAge = defaultAvatarMaxAge
+	}
+
+	dir := options.Dir
+	if options.Thumbnails {
+		dir = filepath.Join(dir, avatarThumbnailDir)
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("creating avatar directory: %w", err)
+	}
+
+	seen := make(map[string]bool)
+	var ids []string
+	for _, id := range avatarIDs {
+		id = strings.TrimSpace(id)
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		files = make(map[string]*AvatarFile, len(ids))
+		errs  []error
+		slots = make(chan struct{}, options.Concurrency)
+	)
+	for _, id := range ids {
+		wg.Go(func() {
+			select {
+			case slots <- struct{}{}:
+				defer func() { <-slots }()
+			case <-ctx.Done():
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("avatar %s: %w", id, ctx.Err()))
+				mu.Unlock()
+				return
+			}
+
+			file, err := c.fetchAvatar(ctx, id, dir, options)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("avatar %s: %w", id, err))
+				return
+			}
+			files[id] = file
+		})
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return files, fmt.Errorf("fetching avatars: %w", errors.Join(errs...))
+	}
+	return files, nil
+}
+
+// fetchAvatar returns a single avatar from the cache, revalidating or downloading it as needed.
+func (c *Client) fetchAvatar(ctx context.Context, avatarID, dir string, options AvatarFetchOptions) (*AvatarFile, error) {
+	path := filepath.Join(dir, avatarFileName(avatarID))
+	metaPath := path + avatarMetaSuffix
+	file := &AvatarFile{AvatarID: avatarID, Path: path}
+
+	var meta avatarMeta
+	cached, err := os.ReadFile(path)
+	if err == nil {
+		if by, err := os.ReadFile(metaPath); err == nil {
+			_ = json.Unmarshal(by, &meta)
+		}
+		if time.Since(meta.CheckedAt) < options.MaxAge {
+			file.Data = cached
+			return file, nil
+		}
+	}
+
+	headers := make(map[string]string)
+	if cached != nil {
+		if meta.ETag != "" {
+			headers["If-None-Match"] = meta.ETag
+		}
+		if meta.LastModified != "" {
+			headers["If-Modified-Since"] = meta.LastModified
+		}
+	}
+
+	endpoint, err := avatarEndpoint(avatarID, options.Thumbnails)
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.getRequestWithHeaders(ctx, endpoint, headers)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.statusCode == http.StatusNotModified && cached != nil {
+		file.Data = cached
+		file.Revalidated = true
+	} else {
+		if err := writeFileAtomic(path, res.body); err != nil {
+			return nil, fmt.Errorf("storing avatar: %w", err)
+		}
+		file.Data = res.body
+		file.Downloaded = true
+		meta = avatarMeta{ETag: res.header.Get("ETag"), LastModified: res.header.Get("Last-Modified")}
+	}
+
+	meta.CheckedAt = time.Now()
+	by, err := json.Marshal(meta)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling avatar metadata: %w", err)
+	}
+	if err := writeFileAtomic(metaPath, by); err != nil {
+		return nil, fmt.Errorf("storing avatar metadata: %w", err)
+	}
+
+	return file, nil
+}
+
+// avatarFileName returns the file name for an avatar ID. IDs that are not safe file names, such as
+// custom team avatar URLs, are hashed.
+func avatarFileName(avatarID string) string {
+	if avatarIDPattern.MatchString(avatarID) {
+		return avatarID
+	}
+	sum := sha256.Sum256([]byte(avatarID))
+	return hex.EncodeToString(sum[:])
+}
+
+// AvatarIDs returns the avatar IDs of the league and its members, for use with FetchAvatars.
+func (d *LeagueDirectory) AvatarIDs() []string {
+	var ids []string
+	if d.League != nil && d.League.Avatar != "" {
+		ids = append(ids, d.League.Avatar)
+	}
+	for _, m := range d.Members {
+		if m.Avatar != "" {
+			ids = append(ids, m.Avatar)
+		}
+	}
+	return ids
+}
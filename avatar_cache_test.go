@@ -0,0 +1,181 @@
+package sleeper
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// etagTransport serves avatars with an ETag and answers matching conditional requests with 304.
+type etagTransport struct {
+	mu          sync.Mutex
+	avatars     map[string][]byte
+	downloads   int
+	notModified int
+}
+
+func (t *etagTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	res := &http.Response{StatusCode: http.StatusNotFound, Header: make(http.Header), Body: io.NopCloser(bytes.NewReader(nil)), Request: req}
+	for id, data := range t.avatars {
+		if req.URL.String() != AvatarThumbnailURL(id) {
+			continue
+		}
+		etag := `"` + id + `"`
+		res.Header.Set("ETag", etag)
+		if req.Header.Get("If-None-Match") == etag {
+			t.notModified++
+			res.StatusCode = http.StatusNotModified
+			return res, nil
+		}
+		t.downloads++
+		res.StatusCode = http.StatusOK
+		res.Body = io.NopCloser(bytes.NewReader(data))
+	}
+	return res, nil
+}
+
+// notModifiedTransport answers every request with 304 Not Modified.
+type notModifiedTransport struct{}
+
+func (notModifiedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return &http.Response{StatusCode: http.StatusNotModified, Header: make(http.Header), Body: io.NopCloser(bytes.NewReader(nil)), Request: req}, nil
+}
+
+func TestRequest_NotModified(t *testing.T) {
+	client := &Client{client: &http.Client{Transport: notModifiedTransport{}}, rateLimiter: rate.NewLimiter(rate.Inf, 1)}
+
+	tt := []struct {
+		testcase   string
+		headers    map[string]string
+		shouldPass bool
+	}{
+		{"if none match", map[string]string{"If-None-Match": `"a1"`}, true},
+		{"if modified since", map[string]string{"If-Modified-Since": "Mon, 01 Sep 2025 00:00:00 GMT"}, true},
+		{"unconditional", nil, false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testcase, func(t *testing.T) {
+			res, err := client.getRequestWithHeaders(context.Background(), AvatarThumbnailURL("a1"), tc.headers)
+			if err != nil {
+				if tc.shouldPass {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+				t.Logf("expected error: %v", err)
+				return
+			}
+
+			if !tc.shouldPass {
+				t.Errorf("expected failure but got status: %d", res.statusCode)
+				return
+			}
+
+			if res.statusCode != http.StatusNotModified {
+				t.Errorf("expected status %d, got %d", http.StatusNotModified, res.statusCode)
+			}
+		})
+	}
+}
+
+func TestAvatar_Fetch(t *testing.T) {
+	transport := &etagTransport{avatars: map[string][]byte{"a1": []byte("one"), "a2": []byte("two")}}
+	client := &Client{client: &http.Client{Transport: transport}, rateLimiter: rate.NewLimiter(rate.Inf, 1)}
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	tt := []struct {
+		testcase            string
+		ids                 []string
+		options             AvatarFetchOptions
+		expectedFiles       int
+		expectedDownloads   int
+		expectedNotModified int
+		shouldPass          bool
+	}{
+		{
+			"initial fetch",
+			[]string{"a1", "a2", "a1", ""},
+			AvatarFetchOptions{Dir: dir, Thumbnails: true},
+			2,
+			2,
+			0,
+			true,
+		},
+		{
+			"fresh cache",
+			[]string{"a1", "a2"},
+			AvatarFetchOptions{Dir: dir, Thumbnails: true},
+			2,
+			2,
+			0,
+			true,
+		},
+		{
+			"revalidated cache",
+			[]string{"a1", "a2"},
+			AvatarFetchOptions{Dir: dir, Thumbnails: true, MaxAge: time.Nanosecond},
+			2,
+			2,
+			2,
+			true,
+		},
+		{
+			"missing avatar",
+			[]string{"a1", "missing"},
+			AvatarFetchOptions{Dir: dir, Thumbnails: true},
+			1,
+			2,
+			2,
+			false,
+		},
+		{
+			"missing dir",
+			[]string{"a1"},
+			AvatarFetchOptions{},
+			0,
+			2,
+			2,
+			false,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testcase, func(t *testing.T) {
+			files, err := client.FetchAvatars(ctx, tc.ids, tc.options)
+			if err != nil {
+				if tc.shouldPass {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+				t.Logf("expected error: %v", err)
+			} else if !tc.shouldPass {
+				t.Errorf("expected failure but got files: %v", files)
+				return
+			}
+
+			if len(files) != tc.expectedFiles {
+				t.Errorf("expected %d files, got %d", tc.expectedFiles, len(files))
+			}
+			if transport.downloads != tc.expectedDownloads || transport.notModified != tc.expectedNotModified {
+				t.Errorf("expected %d downloads and %d revalidations, got %d and %d", tc.expectedDownloads, tc.expectedNotModified, transport.downloads, transport.notModified)
+			}
+			for id, file := range files {
+				data, err := os.ReadFile(file.Path)
+				if err != nil || !bytes.Equal(data, transport.avatars[id]) || !bytes.Equal(file.Data, data) {
+					t.Errorf("unexpected stored avatar %s at %s: %v", id, file.Path, err)
+				}
+			}
+		})
+	}
+}
@@ -3,6 +3,8 @@ package sleeper
 import (
 	"bytes"
 	"context"
+	"image"
+	"image/jpeg"
 	"os"
 	"path/filepath"
 	"testing"
@@ -118,3 +120,145 @@ func TestGetAvatarThumbnail(t *testing.T) {
 		})
 	}
 }
+
+func TestAvatar_URL(t *testing.T) {
+	tt := []struct {
+		testcase          string
+		avatar            string
+		expectedURL       string
+		expectedThumbnail string
+	}{
+		{
+			"avatar ID",
+			"cc12ec49965eb7856f84d71cf85306af",
+			"https://sleepercdn.com/avatars/cc12ec49965eb7856f84d71cf85306af",
+			"https://sleepercdn.com/avatars/thumbs/cc12ec49965eb7856f84d71cf85306af",
+		},
+		{
+			"full URL",
+			"https://sleepercdn.com/uploads/team.jpg",
+			"https://sleepercdn.com/uploads/team.jpg",
+			"https://sleepercdn.com/uploads/team.jpg",
+		},
+		{
+			"URL on another host",
+			"https://example.com/team.jpg",
+			"",
+			"",
+		},
+		{
+			"CDN host lookalike",
+			"https://sleepercdn.com.example.com/team.jpg",
+			"",
+			"",
+		},
+		{
+			"insecure CDN URL",
+			"http://sleepercdn.com/uploads/team.jpg",
+			"",
+			"",
+		},
+		{
+			"no avatar",
+			"",
+			"",
+			"",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testcase, func(t *testing.T) {
+			user := &User{Avatar: tc.avatar}
+			league := &League{Avatar: tc.avatar}
+			if user.AvatarURL() != tc.expectedURL || league.AvatarURL() != tc.expectedURL {
+				t.Errorf("expected URL %q, got %q and %q", tc.expectedURL, user.AvatarURL(), league.AvatarURL())
+			}
+			if user.AvatarThumbnailURL() != tc.expectedThumbnail || league.AvatarThumbnailURL() != tc.expectedThumbnail {
+				t.Errorf("expected thumbnail URL %q, got %q and %q", tc.expectedThumbnail, user.AvatarThumbnailURL(), league.AvatarThumbnailURL())
+			}
+		})
+	}
+}
+
+func TestAvatar_GetOffCDN(t *testing.T) {
+	client, transport := newCDNClient(map[string][]byte{})
+
+	if _, err := client.GetAvatarImage(context.Background(), "https://example.com/team.jpg"); err == nil {
+		t.Errorf("expected failure for an avatar on another host")
+	}
+	if _, err := client.GetAvatarThumbnail(context.Background(), "https://example.com/team.jpg"); err == nil {
+		t.Errorf("expected failure for an avatar on another host")
+	}
+	if len(transport.requests) != 0 {
+		t.Errorf("expected no requests, got %v", transport.requests)
+	}
+}
+
+func TestAvatar_DecodeImage(t *testing.T) {
+	png, err := os.ReadFile(filepath.Join("test", "avatarThumbnail.png"))
+	if err != nil {
+		t.Fatalf("Failed to load test avatar thumbnail: %v", err)
+	}
+
+	var jpg bytes.Buffer
+	if err := jpeg.Encode(&jpg, image.NewRGBA(image.Rect(0, 0, 3, 2)), nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// A lossless WebP header for a 64x32 image.
+	bits := uint32(64-1) | uint32(32-1)<<14
+	webp := append([]byte("RIFF\x00\x00\x00\x00WEBPVP8L\x00\x00\x00\x00\x2f"), byte(bits), byte(bits>>8), byte(bits>>16), byte(bits>>24))
+	webp = append(webp, make([]byte, 16)...)
+
+	tt := []struct {
+		testcase       string
+		data           []byte
+		expectedType   string
+		expectedWidth  int
+		expectedHeight int
+		decodes        bool
+		shouldPass     bool
+	}{
+		{"png", png, "image/png", 0, 0, true, true},
+		{"jpeg", jpg.Bytes(), "image/jpeg", 3, 2, true, true},
+		{"webp", webp, "image/webp", 64, 32, false, true},
+		{"not an image", []byte("<html></html>"), "", 0, 0, false, false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testcase, func(t *testing.T) {
+			info, err := DecodeImageInfo(tc.data)
+			if err != nil {
+				if tc.shouldPass {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+				t.Logf("expected error: %v", err)
+				return
+			}
+
+			if !tc.shouldPass {
+				t.Errorf("expected failure but got image info: %+v", info)
+				return
+			}
+
+			if info.ContentType != tc.expectedType || info.Width < 1 || info.Height < 1 {
+				t.Errorf("unexpected image info: %+v", info)
+				return
+			}
+			if tc.expectedWidth > 0 && (info.Width != tc.expectedWidth || info.Height != tc.expectedHeight) {
+				t.Errorf("expected %dx%d, got %dx%d", tc.expectedWidth, tc.expectedHeight, info.Width, info.Height)
+				return
+			}
+
+			img, _, err := DecodeImage(tc.data)
+			if tc.decodes != (err == nil) {
+				t.Errorf("expected decodes %v, got error: %v", tc.decodes, err)
+				return
+			}
+			if img != nil && (img.Bounds().Dx() != info.Width || img.Bounds().Dy() != info.Height) {
+				t.Errorf("decoded bounds %v do not match %dx%d", img.Bounds(), info.Width, info.Height)
+			}
+		})
+	}
+}
@@ -25,6 +25,10 @@ type Client struct {
 	client      *http.Client
 	baseURL     string
 	rateLimiter *rate.Limiter
+
+	captureUnknownFields bool
+	schemaDriftHook      func(*SchemaDrift)
+	schemas              schemaTracker
 }
 
 // Config holds configuration options for creating a Client.
@@ -33,6 +37,9 @@ type Config struct {
 	Timeout        time.Duration
 	RateLimitRPS   float64 // Requests per second (default: 15)
 	RateLimitBurst int     // Burst capacity (default: 30)
+
+	CaptureUnknownFields bool               // Populate each model's Extra with JSON fields it does not declare
+	SchemaDriftHook      func(*SchemaDrift) // Called when a response's fields differ from its model's (see SchemaDrift)
 }
 
 const (
@@ -59,6 +66,9 @@ func NewClient(ctx context.Context, config Config) (*Client, error) {
 		client:      client,
 		baseURL:     fmt.Sprintf("%s/%s", endpointBaseURL, config.APIVersion),
 		rateLimiter: rateLimiter,
+
+		captureUnknownFields: config.CaptureUnknownFields,
+		schemaDriftHook:      config.SchemaDriftHook,
 	}, nil
 }
 
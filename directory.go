@@ -0,0 +1,166 @@
+package sleeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// DirectoryTeam joins a roster to the league members who own it.
+type DirectoryTeam struct {
+	RosterID int             `json:"roster_id"`
+	TeamName string          `json:"team_name"`
+	Roster   *Roster         `json:"roster"`
+	Owner    *LeagueMember   `json:"owner,omitempty"`     // nil for orphaned rosters
+	CoOwners []*LeagueMember `json:"co_owners,omitempty"` // co-owners who are league members
+}
+
+// Orphaned reports whether the roster has no owner.
+func (t *DirectoryTeam) Orphaned() bool {
+	return t.Owner == nil
+}
+
+// LeagueDirectory joins a league's rosters and members.
+type LeagueDirectory struct {
+	League  *League          `json:"league"`
+	Teams   []*DirectoryTeam `json:"teams"`   // sorted by roster ID
+	Members []*LeagueMember  `json:"members"` // every league member, including those without a roster
+
+	byRosterID map[int]*DirectoryTeam
+	byUserID   map[string]*LeagueMember
+	byTeamName map[string]*DirectoryTeam
+}
+
+// NewLeagueDirectory joins each roster's owner_id and co_owners to the league's members.
+func NewLeagueDirectory(league *League, rosters []*Roster, members []*LeagueMember) *LeagueDirectory {
+	d := &LeagueDirectory{
+		League:     league,
+		byRosterID: make(map[int]*DirectoryTeam),
+		byUserID:   make(map[string]*LeagueMember),
+		byTeamName: make(map[string]*DirectoryTeam),
+	}
+
+	for _, m := range members {
+		if m == nil {
+			continue
+		}
+		d.Members = append(d.Members, m)
+		d.byUserID[m.UserID] = m
+	}
+
+	for _, r := range rosters {
+		if r == nil {
+			continue
+		}
+
+		team := &DirectoryTeam{RosterID: r.RosterID, Roster: r}
+		if r.OwnerID != "" {
+			team.Owner = d.byUserID[r.OwnerID]
+		}
+		for _, userID := range r.CoOwners {
+			if m, ok := d.byUserID[userID]; ok {
+				team.CoOwners = append(team.CoOwners, m)
+			}
+		}
+
+		team.TeamName = fmt.Sprintf("Team %d", r.RosterID)
+		if team.Owner != nil {
+			team.TeamName = team.Owner.TeamName()
+		}
+
+		d.Teams = append(d.Teams, team)
+		d.byRosterID[r.RosterID] = team
+		if _, ok := d.byTeamName[strings.ToLower(team.TeamName)]; !ok {
+			d.byTeamName[strings.ToLower(team.TeamName)] = team
+		}
+	}
+
+	sort.Slice(d.Teams, func(i, j int) bool {
+		return d.Teams[i].RosterID < d.Teams[j].RosterID
+	})
+	return d
+}
+
+// Team returns the team for a roster ID, or nil.
+func (d *LeagueDirectory) Team(rosterID int) *DirectoryTeam {
+	return d.byRosterID[rosterID]
+}
+
+// Member returns the league member with a user ID, or nil.
+func (d *LeagueDirectory) Member(userID string) *LeagueMember {
+	return d.byUserID[userID]
+}
+
+// TeamByName returns the team with a team name, ignoring case, or nil.
+func (d *LeagueDirectory) TeamByName(name string) *DirectoryTeam {
+	return d.byTeamName[strings.ToLower(strings.TrimSpace(name))]
+}
+
+// TeamsForUser returns the teams a user owns or co-owns.
+func (d *LeagueDirectory) TeamsForUser(userID string) []*DirectoryTeam {
+	var teams []*DirectoryTeam
+	for _, t := range d.Teams {
+		if (t.Owner != nil && t.Owner.UserID == userID) || slices.Contains(t.Roster.CoOwners, userID) {
+			teams = append(teams, t)
+		}
+	}
+	return teams
+}
+
+// Orphans returns the teams without an owner.
+func (d *LeagueDirectory) Orphans() []*DirectoryTeam {
+	var teams []*DirectoryTeam
+	for _, t := range d.Teams {
+		if t.Orphaned() {
+			teams = append(teams, t)
+		}
+	}
+	return teams
+}
+
+// CoOwned returns the teams with at least one co-owner.
+func (d *LeagueDirectory) CoOwned() []*DirectoryTeam {
+	var teams []*DirectoryTeam
+	for _, t := range d.Teams {
+		if len(t.CoOwners) > 0 {
+			teams = append(teams, t)
+		}
+	}
+	return teams
+}
+
+// GetLeagueDirectory retrieves a league, its rosters and its members concurrently and joins them.
+func (c *Client) GetLeagueDirectory(ctx context.Context, leagueID string) (*LeagueDirectory, error) {
+	leagueID = strings.TrimSpace(leagueID)
+	if leagueID == "" {
+		return nil, errors.New("leagueID is required")
+	}
+
+	var (
+		wg                              sync.WaitGroup
+		league                          *League
+		rosters                         []*Roster
+		members                         []*LeagueMember
+		leagueErr, rostersErr, usersErr error
+	)
+	wg.Go(func() {
+		league, leagueErr = c.GetLeague(ctx, leagueID)
+	})
+	wg.Go(func() {
+		rosters, rostersErr = c.GetLeagueRosters(ctx, leagueID)
+	})
+	wg.Go(func() {
+		members, usersErr = c.GetLeagueMembers(ctx, leagueID)
+	})
+	wg.Wait()
+
+	if err := errors.Join(leagueErr, rostersErr, usersErr); err != nil {
+		return nil, fmt.Errorf("getting league directory: %w", err)
+	}
+
+	return NewLeagueDirectory(league, rosters, members), nil
+}
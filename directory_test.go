@@ -0,0 +1,61 @@
+package sleeper
+
+import (
+	"testing"
+)
+
+func TestLeagueDirectory_New(t *testing.T) {
+	members := []*LeagueMember{
+		{User: User{UserID: "u1", DisplayName: "Alice"}, Metadata: &LeagueMemberMetadata{TeamName: "Team Taco"}},
+		{User: User{UserID: "u2", DisplayName: "Bob"}},
+		{User: User{UserID: "u3", DisplayName: "Carol"}, IsOwner: true},
+	}
+	rosters := []*Roster{
+		{RosterID: 3},
+		{RosterID: 2, OwnerID: "u2", CoOwners: []string{"u1"}},
+		{RosterID: 1, OwnerID: "u1"},
+	}
+	d := NewLeagueDirectory(&League{LeagueID: "league"}, rosters, members)
+
+	tt := []struct {
+		testcase         string
+		team             *DirectoryTeam
+		expectedRosterID int
+		expectedName     string
+		expectedOrphan   bool
+	}{
+		{"by roster ID", d.Team(1), 1, "Team Taco", false},
+		{"by team name", d.TeamByName("team taco"), 1, "Team Taco", false},
+		{"display name fallback", d.TeamByName("Bob"), 2, "Bob", false},
+		{"orphaned roster", d.Team(3), 3, "Team 3", true},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testcase, func(t *testing.T) {
+			if tc.team == nil {
+				t.Errorf("expected team %d, got nil", tc.expectedRosterID)
+				return
+			}
+			if tc.team.RosterID != tc.expectedRosterID || tc.team.TeamName != tc.expectedName || tc.team.Orphaned() != tc.expectedOrphan {
+				t.Errorf("unexpected team: %+v", tc.team)
+				return
+			}
+		})
+	}
+
+	if d.Teams[0].RosterID != 1 {
+		t.Errorf("expected teams sorted by roster ID, got %d first", d.Teams[0].RosterID)
+	}
+	if teams := d.TeamsForUser("u1"); len(teams) != 2 {
+		t.Errorf("expected u1 to own or co-own 2 teams, got %d", len(teams))
+	}
+	if orphans := d.Orphans(); len(orphans) != 1 || orphans[0].RosterID != 3 {
+		t.Errorf("unexpected orphans: %v", orphans)
+	}
+	if coOwned := d.CoOwned(); len(coOwned) != 1 || coOwned[0].CoOwners[0].UserID != "u1" {
+		t.Errorf("unexpected co-owned teams: %v", coOwned)
+	}
+	if m := d.Member("u3"); m == nil || !m.IsCommissioner() || len(d.TeamsForUser("u3")) != 0 {
+		t.Errorf("expected commissioner without a roster, got %v", m)
+	}
+}
@@ -0,0 +1,253 @@
+package sleeper
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+const (
+	draftTypeSnake   = "snake"
+	draftTypeAuction = "auction"
+)
+
+// DraftBoardSlot describes a column of the draft board.
+type DraftBoardSlot struct {
+	Slot     int    `json:"slot"`
+	RosterID int    `json:"roster_id"`
+	UserID   string `json:"user_id,omitempty"`
+}
+
+// DraftBoardCell is a single pick on the draft board.
+type DraftBoardCell struct {
+	Round            int        `json:"round"`
+	Slot             int        `json:"slot"`
+	PickNo           int        `json:"pick_no"`
+	OriginalRosterID int        `json:"original_roster_id"` // roster the pick originally belonged to
+	RosterID         int        `json:"roster_id"`          // roster that owns (or made) the pick
+	Traded           bool       `json:"traded"`
+	Pick             *DraftPick `json:"pick,omitempty"` // nil until the pick has been made
+}
+
+// Label returns a short description of the player selected with the pick, or an empty string.
+func (c *DraftBoardCell) Label() string {
+	if c.Pick == nil {
+		return ""
+	}
+	if c.Pick.Metadata == nil {
+		return c.Pick.PlayerID
+	}
+
+	m := c.Pick.Metadata
+	name := strings.TrimSpace(m.FirstName + " " + m.LastName)
+	if name == "" {
+		name = c.Pick.PlayerID
+	}
+	if m.Position == "" {
+		return name
+	}
+	if m.Team == "" {
+		return fmt.Sprintf("%s %s", name, m.Position)
+	}
+	return fmt.Sprintf("%s %s-%s", name, m.Position, m.Team)
+}
+
+// DraftBoard lays the picks of a draft into a rounds by slots grid.
+type DraftBoard struct {
+	Draft  *Draft              `json:"draft"`
+	Rounds int                 `json:"rounds"`
+	Teams  int                 `json:"teams"`
+	Slots  []*DraftBoardSlot   `json:"slots"`
+	Cells  [][]*DraftBoardCell `json:"cells"` // indexed by [round-1][slot-1]
+}
+
+// NewDraftBoard builds a DraftBoard from a draft, its picks (see GetDraftPicks) and its traded picks
+// (see GetDraftTradedPicks). Snake, linear and third-round-reversal drafts are supported. Every slot must be
+// assigned to a roster in SlotToRosterID.
+func NewDraftBoard(draft *Draft, picks []*DraftPick, traded []*TradedDraftPick) (*DraftBoard, error) {
+	if draft == nil {
+		return nil, errors.New("draft is required")
+	}
+	teams, rounds, err := draftDimensions(draft)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkDraftSlots(draft, teams); err != nil {
+		return nil, err
+	}
+
+	board := &DraftBoard{
+		Draft:  draft,
+		Rounds: rounds,
+		Teams:  teams,
+	}
+
+	userBySlot := make(map[int]string)
+	for userID, slot := range draft.DraftOrder {
+		userBySlot[slot] = userID
+	}
+	for slot := 1; slot <= teams; slot++ {
+		board.Slots = append(board.Slots, &DraftBoardSlot{
+			Slot:     slot,
+			RosterID: draft.SlotToRosterID[strconv.Itoa(slot)],
+			UserID:   userBySlot[slot],
+		})
+	}
+
+	board.Cells = make([][]*DraftBoardCell, rounds)
+	for round := range board.Cells {
+		board.Cells[round] = make([]*DraftBoardCell, teams)
+	}
+	for _, p := range draftSequence(draft, teams, rounds, traded) {
+		board.Cells[p.Round-1][p.Slot-1] = &DraftBoardCell{
+			Round:            p.Round,
+			Slot:             p.Slot,
+			PickNo:           p.PickNo,
+			OriginalRosterID: p.OriginalRosterID,
+			RosterID:         p.RosterID,
+			Traded:           p.Traded,
+		}
+	}
+
+	for _, pick := range picks {
+		if pick == nil {
+			continue
+		}
+		cell := board.Cell(pick.Round, pick.DraftSlot)
+		if cell == nil {
+			continue
+		}
+		cell.Pick = pick
+		if pick.PickNo > 0 {
+			cell.PickNo = pick.PickNo
+		}
+		if pick.RosterID > 0 && pick.RosterID != cell.RosterID {
+			cell.RosterID = pick.RosterID
+			cell.Traded = pick.RosterID != cell.OriginalRosterID
+		}
+	}
+
+	return board, nil
+}
+
+// Cell returns the cell for a round and slot, or nil if either is out of range.
+func (b *DraftBoard) Cell(round, slot int) *DraftBoardCell {
+	if round < 1 || round > len(b.Cells) || slot < 1 || slot > len(b.Cells[round-1]) {
+		return nil
+	}
+	return b.Cells[round-1][slot-1]
+}
+
+// cellText returns the text shown for a cell in text and CSV output.
+func (c *DraftBoardCell) cellText() string {
+	text := c.Label()
+	if text == "" {
+		text = fmt.Sprintf("%d.%02d", c.Round, c.Slot)
+	}
+	if c.Traded {
+		text = fmt.Sprintf("%s (R%d)", text, c.RosterID)
+	}
+	return text
+}
+
+// header returns the column headings for the board.
+func (b *DraftBoard) header() []string {
+	header := []string{"Round"}
+	for _, s := range b.Slots {
+		header = append(header, fmt.Sprintf("Slot %d (R%d)", s.Slot, s.RosterID))
+	}
+	return header
+}
+
+// WriteText renders the board as an aligned plain-text table. Traded picks show the owning roster in parentheses.
+func (b *DraftBoard) WriteText(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(b.header(), "\t"))
+	for _, row := range b.Cells {
+		cols := []string{strconv.Itoa(row[0].Round)}
+		for _, cell := range row {
+			cols = append(cols, cell.cellText())
+		}
+		fmt.Fprintln(tw, strings.Join(cols, "\t"))
+	}
+	return tw.Flush()
+}
+
+// Text returns the board rendered as plain text.
+func (b *DraftBoard) Text() string {
+	var sb strings.Builder
+	_ = b.WriteText(&sb)
+	return sb.String()
+}
+
+// WriteCSV renders the board as CSV with one row per round.
+func (b *DraftBoard) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(b.header()); err != nil {
+		return fmt.Errorf("writing draft board csv: %w", err)
+	}
+	for _, row := range b.Cells {
+		cols := []string{strconv.Itoa(row[0].Round)}
+		for _, cell := range row {
+			cols = append(cols, cell.cellText())
+		}
+		if err := cw.Write(cols); err != nil {
+			return fmt.Errorf("writing draft board csv: %w", err)
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+var draftBoardTemplate = template.Must(template.New("draftBoard").Parse(`<table class="draft-board">
+<thead><tr><th>Round</th>{{range .Slots}}<th data-roster-id="{{.RosterID}}">Slot {{.Slot}}</th>{{end}}</tr></thead>
+<tbody>
+{{- range .Cells}}
+<tr>{{with index . 0}}<th>{{.Round}}</th>{{end}}{{range .}}<td data-pick-no="{{.PickNo}}" data-roster-id="{{.RosterID}}"{{if .Traded}} class="traded"{{end}}><span class="pick">{{.Round}}.{{printf "%02d" .Slot}}</span> {{.Label}}</td>{{end}}</tr>
+{{- end}}
+</tbody>
+</table>
+`))
+
+// WriteHTML renders the board as an HTML table. Traded picks are marked with the "traded" class.
+func (b *DraftBoard) WriteHTML(w io.Writer) error {
+	if err := draftBoardTemplate.Execute(w, b); err != nil {
+		return fmt.Errorf("writing draft board html: %w", err)
+	}
+	return nil
+}
+
+// GetDraftBoard retrieves a draft, its picks and its traded picks and lays them out as a DraftBoard. If the
+// draft's slots have not been assigned to rosters yet, they are assigned from the draft order and the
+// league's rosters.
+func (c *Client) GetDraftBoard(ctx context.Context, draftID string) (*DraftBoard, error) {
+	draft, err := c.GetDraft(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("getting draft board: %w", err)
+	}
+	if draft == nil {
+		return nil, errors.New("draft not found")
+	}
+
+	if err := c.assignLeagueDraftSlots(ctx, draft); err != nil {
+		return nil, fmt.Errorf("getting draft board: %w", err)
+	}
+
+	picks, err := c.GetDraftPicks(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("getting draft board: %w", err)
+	}
+
+	traded, err := c.GetDraftTradedPicks(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("getting draft board: %w", err)
+	}
+
+	return NewDraftBoard(draft, picks, traded)
+}
@@ -0,0 +1,165 @@
+package sleeper
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+)
+
+func testDraft(draftType string, reversalRound int) *Draft {
+	return &Draft{
+		DraftID:        "draft",
+		Season:         "2025",
+		Type:           draftType,
+		DraftOrder:     map[string]int{"u1": 1, "u2": 2, "u3": 3, "u4": 4},
+		SlotToRosterID: map[string]int{"1": 4, "2": 3, "3": 2, "4": 1},
+		Settings:       &DraftSettings{Teams: 4, Rounds: 4, ReversalRound: FlexInt(reversalRound)},
+	}
+}
+
+func TestDraftBoard_New(t *testing.T) {
+	tt := []struct {
+		testcase string
+		draft    *Draft
+		// expected pick numbers for slot 1 in rounds 1-4
+		expectedPickNos []int
+		shouldPass      bool
+	}{
+		{
+			"snake",
+			testDraft("snake", 0),
+			[]int{1, 8, 9, 16},
+			true,
+		},
+		{
+			"linear",
+			testDraft("linear", 0),
+			[]int{1, 5, 9, 13},
+			true,
+		},
+		{
+			"third round reversal",
+			testDraft("snake", 3),
+			[]int{1, 8, 12, 13},
+			true,
+		},
+		{
+			"missing settings",
+			&Draft{Type: "snake"},
+			nil,
+			false,
+		},
+		{
+			"missing draft",
+			nil,
+			nil,
+			false,
+		},
+		{
+			"unassigned slot",
+			&Draft{
+				Type:           "snake",
+				SlotToRosterID: map[string]int{"1": 4, "2": 3, "3": 2},
+				Settings:       &DraftSettings{Teams: 4, Rounds: 4},
+			},
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testcase, func(t *testing.T) {
+			board, err := NewDraftBoard(tc.draft, nil, nil)
+			if err != nil {
+				if tc.shouldPass {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+				t.Logf("expected error: %v", err)
+				return
+			}
+
+			if !tc.shouldPass {
+				t.Errorf("expected failure but got board: %v", board)
+				return
+			}
+
+			for i, expected := range tc.expectedPickNos {
+				if got := board.Cell(i+1, 1).PickNo; got != expected {
+					t.Errorf("round %d: expected pick %d, got %d", i+1, expected, got)
+				}
+			}
+		})
+	}
+}
+
+func TestDraftBoard_Render(t *testing.T) {
+	picks := []*DraftPick{
+		{
+			Round:     1,
+			DraftSlot: 1,
+			PickNo:    1,
+			RosterID:  4,
+			PlayerID:  "4034",
+			Metadata:  &DraftPickMetadata{FirstName: "Christian", LastName: "McCaffrey", Position: "RB", Team: "SF"},
+		},
+	}
+	traded := []*TradedDraftPick{
+		{Season: "2025", Round: 2, RosterID: 3, PreviousOwnerID: 3, OwnerID: 1},
+	}
+
+	board, err := NewDraftBoard(testDraft("snake", 0), picks, traded)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if cell := board.Cell(2, 2); !cell.Traded || cell.RosterID != 1 {
+		t.Errorf("expected 2.02 to be traded to roster 1, got %+v", cell)
+	}
+
+	if text := board.Text(); !strings.Contains(text, "Christian McCaffrey RB-SF") || !strings.Contains(text, "(R1)") {
+		t.Errorf("unexpected text board:\n%s", text)
+	}
+
+	var csvOut bytes.Buffer
+	if err := board.WriteCSV(&csvOut); err != nil {
+		t.Fatalf("unexpected error writing csv: %v", err)
+	}
+	if lines := strings.Count(csvOut.String(), "\n"); lines != 5 {
+		t.Errorf("expected 5 csv lines, got %d", lines)
+	}
+
+	var htmlOut bytes.Buffer
+	if err := board.WriteHTML(&htmlOut); err != nil {
+		t.Fatalf("unexpected error writing html: %v", err)
+	}
+	if !strings.Contains(htmlOut.String(), `class="traded"`) {
+		t.Errorf("expected traded pick to be marked in html output")
+	}
+}
+
+func TestGetDraftBoard(t *testing.T) {
+	c, _ := newAPIClient(map[string]string{
+		"/draft/d1":              `{"draft_id": "d1", "league_id": "l1", "season": "2025", "type": "snake", "draft_order": {"u1": 2, "u2": 1}, "slot_to_roster_id": null, "settings": {"teams": 2, "rounds": 1}}`,
+		"/draft/d1/picks":        `[{"round": 1, "draft_slot": 1, "pick_no": 1, "roster_id": 2, "player_id": "p1"}]`,
+		"/draft/d1/traded_picks": `[]`,
+		"/league/l1/rosters":     `[{"roster_id": 1, "owner_id": "u1"}, {"roster_id": 2, "owner_id": "u2"}]`,
+		"/draft/missing":         `null`,
+	})
+
+	board, err := c.GetDraftBoard(context.Background(), "d1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if board.Slots[0].RosterID != 2 || board.Slots[1].RosterID != 1 {
+		t.Errorf("expected slots assigned to rosters 2 and 1, got %d and %d", board.Slots[0].RosterID, board.Slots[1].RosterID)
+	}
+	if cell := board.Cell(1, 1); cell.OriginalRosterID != 2 || cell.Traded {
+		t.Errorf("expected an untraded pick from roster 2, got %+v", cell)
+	}
+
+	if _, err := c.GetDraftBoard(context.Background(), "missing"); err == nil {
+		t.Errorf("expected failure for a draft that does not exist")
+	}
+}
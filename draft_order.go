@@ -0,0 +1,198 @@
+package sleeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// DraftOrderPick is a single pick in a draft's pick sequence.
+type DraftOrderPick struct {
+	PickNo           int  `json:"pick_no"`       // overall pick number
+	Round            int  `json:"round"`         // round of the pick
+	PickInRound      int  `json:"pick_in_round"` // position within the round
+	Slot             int  `json:"slot"`          // draft slot the pick belongs to
+	OriginalRosterID int  `json:"original_roster_id"`
+	RosterID         int  `json:"roster_id"` // roster that owns the pick after trades
+	Traded           bool `json:"traded"`
+}
+
+// Label returns the pick in round.pick notation, e.g. "1.07".
+func (p *DraftOrderPick) Label() string {
+	return fmt.Sprintf("%d.%02d", p.Round, p.PickInRound)
+}
+
+// CalculateDraftOrder computes the full pick sequence of a snake, linear or third-round-reversal draft,
+// overlaying traded picks (see GetDraftTradedPicks) so each pick shows the roster that will make it.
+// It does not require any picks to have been made, so it can be used before the draft starts, but every
+// slot must be assigned to a roster in SlotToRosterID.
+func CalculateDraftOrder(draft *Draft, traded []*TradedDraftPick) ([]*DraftOrderPick, error) {
+	if draft == nil {
+		return nil, errors.New("draft is required")
+	}
+	if draft.Type == draftTypeAuction {
+		return nil, errors.New("auction drafts do not have a pick order")
+	}
+
+	teams, rounds, err := draftDimensions(draft)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkDraftSlots(draft, teams); err != nil {
+		return nil, err
+	}
+
+	return draftSequence(draft, teams, rounds, traded), nil
+}
+
+// PicksByRoster groups a pick sequence by the roster that owns each pick.
+func PicksByRoster(order []*DraftOrderPick) map[int][]*DraftOrderPick {
+	picks := make(map[int][]*DraftOrderPick)
+	for _, p := range order {
+		picks[p.RosterID] = append(picks[p.RosterID], p)
+	}
+	return picks
+}
+
+// GetDraftOrder retrieves a draft and its traded picks and computes the draft's full pick sequence. If the
+// draft's slots have not been assigned to rosters yet, they are assigned from the draft order and the
+// league's rosters.
+func (c *Client) GetDraftOrder(ctx context.Context, draftID string) ([]*DraftOrderPick, error) {
+	draft, err := c.GetDraft(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("getting draft order: %w", err)
+	}
+	if draft == nil {
+		return nil, errors.New("draft not found")
+	}
+
+	if err := c.assignLeagueDraftSlots(ctx, draft); err != nil {
+		return nil, fmt.Errorf("getting draft order: %w", err)
+	}
+
+	traded, err := c.GetDraftTradedPicks(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("getting draft order: %w", err)
+	}
+
+	return CalculateDraftOrder(draft, traded)
+}
+
+// assignLeagueDraftSlots fetches the draft's league rosters and assigns its slots when SlotToRosterID is empty.
+func (c *Client) assignLeagueDraftSlots(ctx context.Context, draft *Draft) error {
+	if len(draft.SlotToRosterID) > 0 || len(draft.DraftOrder) == 0 || draft.LeagueID == "" {
+		return nil
+	}
+
+	rosters, err := c.GetLeagueRosters(ctx, draft.LeagueID)
+	if err != nil {
+		return err
+	}
+	assignDraftSlots(draft, rosters)
+	return nil
+}
+
+// assignDraftSlots fills an empty SlotToRosterID from the draft order, which maps user IDs to slots, using
+// the roster each user owns or co-owns. Users without a roster leave their slot unassigned.
+func assignDraftSlots(draft *Draft, rosters []*Roster) {
+	if len(draft.SlotToRosterID) > 0 {
+		return
+	}
+
+	draft.SlotToRosterID = make(map[string]int, len(draft.DraftOrder))
+	for userID, slot := range draft.DraftOrder {
+		if r := FindUserRoster(rosters, userID); r != nil {
+			draft.SlotToRosterID[strconv.Itoa(slot)] = r.RosterID
+		}
+	}
+}
+
+// checkDraftSlots returns an error if any of a draft's slots is not assigned to a roster.
+func checkDraftSlots(draft *Draft, teams int) error {
+	for slot := 1; slot <= teams; slot++ {
+		if _, ok := draft.SlotToRosterID[strconv.Itoa(slot)]; !ok {
+			return fmt.Errorf("draft slot %d is not assigned to a roster", slot)
+		}
+	}
+	return nil
+}
+
+// draftDimensions returns the number of teams and rounds in a draft.
+func draftDimensions(draft *Draft) (int, int, error) {
+	if draft.Settings == nil {
+		return 0, 0, errors.New("draft settings are required")
+	}
+
+	teams := draft.Settings.Teams.Int()
+	if teams < 1 {
+		teams = len(draft.SlotToRosterID)
+	}
+	rounds := draft.Settings.Rounds.Int()
+	if teams < 1 || rounds < 1 {
+		return 0, 0, errors.New("draft has no teams or rounds configured")
+	}
+
+	return teams, rounds, nil
+}
+
+// draftSequence returns every pick of a draft ordered by overall pick number.
+func draftSequence(draft *Draft, teams, rounds int, traded []*TradedDraftPick) []*DraftOrderPick {
+	owners := make(map[string]int)
+	for _, t := range traded {
+		if t != nil {
+			owners[PickAsset(t.Season, t.Round, t.RosterID).Key()] = t.OwnerID
+		}
+	}
+
+	sequence := make([]*DraftOrderPick, 0, teams*rounds)
+	for round := 1; round <= rounds; round++ {
+		for pick := 1; pick <= teams; pick++ {
+			slot := draftPickInRound(draft, round, pick, teams)
+			original := draft.SlotToRosterID[strconv.Itoa(slot)]
+			p := &DraftOrderPick{
+				PickNo:           (round-1)*teams + pick,
+				Round:            round,
+				PickInRound:      pick,
+				Slot:             slot,
+				OriginalRosterID: original,
+				RosterID:         original,
+			}
+			if owner, ok := owners[PickAsset(draft.Season, round, original).Key()]; ok && owner != original {
+				p.RosterID = owner
+				p.Traded = true
+			}
+			sequence = append(sequence, p)
+		}
+	}
+
+	return sequence
+}
+
+// draftPickInRound maps a slot to the position within a round at which it picks. The mapping is its
+// own inverse, so it also maps a position within a round back to the slot picking there.
+// Snake drafts reverse direction every round; with a reversal round set (e.g. third-round reversal),
+// the reversal round repeats the previous round's direction before snaking resumes.
+func draftPickInRound(draft *Draft, round, slot, teams int) int {
+	if draft.Type != draftTypeSnake {
+		return slot
+	}
+
+	reversalRound := 0
+	if draft.Settings != nil {
+		reversalRound = draft.Settings.ReversalRound.Int()
+	}
+	if !draftRoundReversed(round, reversalRound) {
+		return slot
+	}
+	return teams + 1 - slot
+}
+
+// draftRoundReversed reports whether a snake draft round runs from the last slot to the first.
+func draftRoundReversed(round, reversalRound int) bool {
+	flips := round - 1
+	if reversalRound > 1 && round >= reversalRound {
+		flips--
+	}
+	return flips%2 == 1
+}
@@ -0,0 +1,145 @@
+package sleeper
+
+import (
+	"context"
+	"testing"
+)
+
+func TestDraftOrder_Calculate(t *testing.T) {
+	traded := []*TradedDraftPick{
+		{Season: "2025", Round: 3, RosterID: 4, PreviousOwnerID: 4, OwnerID: 1},
+	}
+
+	tt := []struct {
+		testcase string
+		draft    *Draft
+		// expected labels of the picks owned by roster 4 (slot 1)
+		expectedLabels []string
+		shouldPass     bool
+	}{
+		{
+			"snake",
+			testDraft("snake", 0),
+			[]string{"1.01", "2.04", "4.04"},
+			true,
+		},
+		{
+			"linear",
+			testDraft("linear", 0),
+			[]string{"1.01", "2.01", "4.01"},
+			true,
+		},
+		{
+			"third round reversal",
+			testDraft("snake", 3),
+			[]string{"1.01", "2.04", "4.01"},
+			true,
+		},
+		{
+			"auction",
+			testDraft("auction", 0),
+			nil,
+			false,
+		},
+		{
+			"missing draft",
+			nil,
+			nil,
+			false,
+		},
+		{
+			"slots not assigned to rosters",
+			&Draft{Season: "2025", Type: "snake", DraftOrder: map[string]int{"u1": 1, "u2": 2, "u3": 3, "u4": 4}, Settings: &DraftSettings{Teams: 4, Rounds: 4}},
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testcase, func(t *testing.T) {
+			order, err := CalculateDraftOrder(tc.draft, traded)
+			if err != nil {
+				if tc.shouldPass {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+				t.Logf("expected error: %v", err)
+				return
+			}
+
+			if !tc.shouldPass {
+				t.Errorf("expected failure but got order: %v", order)
+				return
+			}
+
+			if len(order) != 16 {
+				t.Errorf("expected 16 picks, got %d", len(order))
+				return
+			}
+
+			picks := PicksByRoster(order)[4]
+			if len(picks) != len(tc.expectedLabels) {
+				t.Errorf("expected %d picks for roster 4, got %d", len(tc.expectedLabels), len(picks))
+				return
+			}
+			for i, p := range picks {
+				if p.Label() != tc.expectedLabels[i] {
+					t.Errorf("expected pick %s, got %s", tc.expectedLabels[i], p.Label())
+				}
+			}
+
+			// Roster 1 received roster 4's third round pick.
+			if got := len(PicksByRoster(order)[1]); got != 5 {
+				t.Errorf("expected 5 picks for roster 1, got %d", got)
+			}
+		})
+	}
+}
+
+func TestDraftOrder_AssignSlots(t *testing.T) {
+	draft := testDraft("snake", 0)
+	draft.SlotToRosterID = nil
+	rosters := []*Roster{
+		{RosterID: 4, OwnerID: "u1"},
+		{RosterID: 3, OwnerID: "u2"},
+		{RosterID: 2, OwnerID: "u3"},
+		{RosterID: 1, OwnerID: "owner", CoOwners: []string{"u4"}},
+	}
+
+	assignDraftSlots(draft, rosters)
+	order, err := CalculateDraftOrder(draft, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if order[0].RosterID != 4 || order[3].RosterID != 1 {
+		t.Errorf("expected rosters 4 and 1 at picks 1 and 4, got %d and %d", order[0].RosterID, order[3].RosterID)
+	}
+
+	// A user without a roster leaves their slot unassigned.
+	draft.SlotToRosterID = nil
+	assignDraftSlots(draft, rosters[:3])
+	if _, err := CalculateDraftOrder(draft, nil); err == nil {
+		t.Errorf("expected failure for an unassigned slot")
+	}
+}
+
+func TestGetDraftOrder(t *testing.T) {
+	c, _ := newAPIClient(map[string]string{
+		"/draft/d1":              `{"draft_id": "d1", "league_id": "l1", "season": "2025", "type": "linear", "draft_order": {"u1": 2, "u2": 1}, "slot_to_roster_id": null, "settings": {"teams": 2, "rounds": 2}}`,
+		"/draft/d1/traded_picks": `[]`,
+		"/league/l1/rosters":     `[{"roster_id": 1, "owner_id": "u1"}, {"roster_id": 2, "owner_id": "u2"}]`,
+		"/draft/missing":         `null`,
+	})
+
+	order, err := c.GetDraftOrder(context.Background(), "d1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(order) != 4 || order[0].RosterID != 2 || order[1].RosterID != 1 {
+		t.Errorf("expected roster 2 then roster 1 from the draft order, got %+v", order)
+	}
+
+	if _, err := c.GetDraftOrder(context.Background(), "missing"); err == nil {
+		t.Errorf("expected failure for a draft that does not exist")
+	}
+}
@@ -0,0 +1,264 @@
+package sleeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type draftEventType string
+
+const (
+	DraftEventPickMade   draftEventType = "pick_made"
+	DraftEventStarted    draftEventType = "draft_started"
+	DraftEventPaused     draftEventType = "draft_paused"
+	DraftEventResumed    draftEventType = "draft_resumed"
+	DraftEventCompleted  draftEventType = "draft_completed"
+	DraftEventOnTheClock draftEventType = "on_the_clock"
+	DraftEventError      draftEventType = "error"
+)
+
+const (
+	draftStatusDrafting = "drafting"
+	draftStatusPaused   = "paused"
+
+	defaultDraftWatchInterval = 5 * time.Second
+	draftWatchRequests        = 3 // requests made per poll (draft, picks and traded picks)
+)
+
+// DraftEvent is emitted by a DraftWatcher when the state of a draft changes.
+type DraftEvent struct {
+	Type     draftEventType  `json:"type"`
+	DraftID  string          `json:"draft_id"`
+	Time     time.Time       `json:"time"`               // when the change was observed
+	Status   string          `json:"status,omitempty"`   // draft status, for status events
+	Pick     *DraftPick      `json:"pick,omitempty"`     // the pick made, for pick events
+	OnClock  *DraftOrderPick `json:"on_clock,omitempty"` // the pick now on the clock, for on-the-clock events
+	Deadline time.Time       `json:"deadline,omitzero"`  // when the pick timer expires, if the draft has one
+	Err      error           `json:"-"`                  // polling error, for error events
+}
+
+// DraftWatcherOptions holds options for a DraftWatcher.
+type DraftWatcherOptions struct {
+	Interval   time.Duration // Polling interval (default: 5s, never faster than the client's rate limit allows)
+	BufferSize int           // Event channel buffer size (default: 64)
+}
+
+// DraftWatcher polls a draft and emits typed events as picks are made and the draft's status changes.
+type DraftWatcher struct {
+	client   *Client
+	draftID  string
+	interval time.Duration
+	buffer   int
+
+	seeded bool // whether the first poll has recorded the draft's initial state
+	draft  *Draft
+	picks  map[int]*DraftPick
+	clock  int // pick number on the clock
+}
+
+// NewDraftWatcher creates a DraftWatcher for the given draft.
+func NewDraftWatcher(client *Client, draftID string, options DraftWatcherOptions) (*DraftWatcher, error) {
+	var errs []string
+	if client == nil {
+		errs = append(errs, "client is required")
+	}
+	draftID = strings.TrimSpace(draftID)
+	if draftID == "" {
+		errs = append(errs, "draftID is required")
+	}
+	if options.Interval < 0 {
+		errs = append(errs, "Interval must be greater than or equal to zero")
+	}
+	if options.BufferSize < 0 {
+		errs = append(errs, "BufferSize must be greater than or equal to zero")
+	}
+	if len(errs) > 0 {
+		return nil, errors.New(strings.Join(errs, "\n"))
+	}
+
+	if options.Interval == 0 {
+		options.Interval = defaultDraftWatchInterval
+	}
+	if options.BufferSize == 0 {
+		options.BufferSize = 64
+	}
+
+	// Each poll makes several requests; never poll faster than the rate limiter can sustain.
+	if limit := float64(client.rateLimiter.Limit()); limit > 0 {
+		minInterval := time.Duration(float64(draftWatchRequests) / limit * float64(time.Second))
+		if options.Interval < minInterval {
+			options.Interval = minInterval
+		}
+	}
+
+	return &DraftWatcher{
+		client:   client,
+		draftID:  draftID,
+		interval: options.Interval,
+		buffer:   options.BufferSize,
+		picks:    make(map[int]*DraftPick),
+	}, nil
+}
+
+// Watch starts polling the draft and returns a channel of events. The channel is closed when the context
+// is cancelled or the draft completes. Polling errors are emitted as DraftEventError events and polling continues.
+// The first poll records the draft's current state, so only the pick on the clock is reported for a draft
+// that is already running.
+func (w *DraftWatcher) Watch(ctx context.Context) <-chan *DraftEvent {
+	events := make(chan *DraftEvent, w.buffer)
+
+	go func() {
+		defer close(events)
+
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for {
+			done, err := w.poll(ctx, events)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				if !w.emit(ctx, events, &DraftEvent{Type: DraftEventError, DraftID: w.draftID, Time: time.Now(), Err: err}) {
+					return
+				}
+			}
+			if done {
+				return
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return events
+}
+
+// poll fetches the current draft state and emits events for any changes. It reports whether the draft has completed.
+func (w *DraftWatcher) poll(ctx context.Context, events chan<- *DraftEvent) (bool, error) {
+	draft, err := w.client.GetDraft(ctx, w.draftID)
+	if err != nil {
+		return false, fmt.Errorf("polling draft: %w", err)
+	}
+	if draft == nil {
+		return false, errors.New("draft not found")
+	}
+
+	picks, err := w.client.GetDraftPicks(ctx, w.draftID)
+	if err != nil {
+		return false, fmt.Errorf("polling draft picks: %w", err)
+	}
+
+	// Picks can be traded while the draft is running, so traded picks are fetched on every poll.
+	traded, err := w.client.GetDraftTradedPicks(ctx, w.draftID)
+	if err != nil {
+		return false, fmt.Errorf("polling draft traded picks: %w", err)
+	}
+
+	for _, event := range w.diff(draft, picks, traded, time.Now()) {
+		if !w.emit(ctx, events, event) {
+			return false, ctx.Err()
+		}
+	}
+
+	return draft.Status == draftStatusComplete, nil
+}
+
+// emit sends an event, giving up if the context is cancelled.
+func (w *DraftWatcher) emit(ctx context.Context, events chan<- *DraftEvent, event *DraftEvent) bool {
+	select {
+	case events <- event:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// diff compares the new draft state with the previous state, records it, and returns the resulting events.
+// The first call only records the state, so attaching to a running draft does not replay its start and past
+// picks; it reports the pick currently on the clock.
+func (w *DraftWatcher) diff(draft *Draft, picks []*DraftPick, traded []*TradedDraftPick, now time.Time) []*DraftEvent {
+	var events []*DraftEvent
+	seeding := !w.seeded
+	w.seeded = true
+
+	previousStatus := ""
+	if w.draft != nil {
+		previousStatus = w.draft.Status
+	}
+	if draft.Status != previousStatus && !seeding {
+		if eventType, ok := draftStatusEvent(previousStatus, draft.Status); ok {
+			events = append(events, &DraftEvent{Type: eventType, DraftID: w.draftID, Time: now, Status: draft.Status})
+		}
+	}
+	w.draft = draft
+
+	latest := 0
+	for _, pick := range picks {
+		if pick == nil {
+			continue
+		}
+		if pick.PickNo > latest {
+			latest = pick.PickNo
+		}
+		if _, seen := w.picks[pick.PickNo]; seen {
+			continue
+		}
+		w.picks[pick.PickNo] = pick
+		if !seeding {
+			events = append(events, &DraftEvent{Type: DraftEventPickMade, DraftID: w.draftID, Time: now, Pick: pick})
+		}
+	}
+
+	if draft.Status != draftStatusDrafting {
+		return events
+	}
+
+	// The next pick in the sequence is on the clock.
+	next := latest + 1
+	if next == w.clock {
+		return events
+	}
+	w.clock = next
+
+	event := &DraftEvent{Type: DraftEventOnTheClock, DraftID: w.draftID, Time: now}
+	if order, err := CalculateDraftOrder(draft, traded); err == nil && next <= len(order) {
+		event.OnClock = order[next-1]
+	}
+	if draft.Settings != nil && draft.Settings.PickTimer > 0 {
+		started := now
+		if !draft.LastPicked.IsZero() {
+			started = draft.LastPicked.Time()
+		} else if !draft.StartTime.IsZero() {
+			started = draft.StartTime.Time()
+		}
+		event.Deadline = started.Add(time.Duration(draft.Settings.PickTimer) * time.Second)
+	}
+	events = append(events, event)
+
+	return events
+}
+
+// draftStatusEvent maps a change in draft status to an event type.
+func draftStatusEvent(previous, current string) (draftEventType, bool) {
+	switch current {
+	case draftStatusDrafting:
+		if previous == draftStatusPaused {
+			return DraftEventResumed, true
+		}
+		return DraftEventStarted, true
+	case draftStatusPaused:
+		return DraftEventPaused, true
+	case draftStatusComplete:
+		return DraftEventCompleted, true
+	default:
+		return "", false
+	}
+}
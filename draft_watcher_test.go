@@ -0,0 +1,199 @@
+package sleeper
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestDraftWatcher_New(t *testing.T) {
+	tt := []struct {
+		testcase    string
+		draftID     string
+		options     DraftWatcherOptions
+		minInterval time.Duration
+		shouldPass  bool
+	}{
+		{
+			"default options",
+			"483459723828391936",
+			DraftWatcherOptions{},
+			defaultDraftWatchInterval,
+			true,
+		},
+		{
+			"interval clamped to rate limit",
+			"483459723828391936",
+			DraftWatcherOptions{Interval: time.Millisecond},
+			130 * time.Millisecond,
+			true,
+		},
+		{
+			"missing draft ID",
+			"",
+			DraftWatcherOptions{},
+			0,
+			false,
+		},
+		{
+			"negative interval",
+			"483459723828391936",
+			DraftWatcherOptions{Interval: -1},
+			0,
+			false,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testcase, func(t *testing.T) {
+			watcher, err := NewDraftWatcher(testClient, tc.draftID, tc.options)
+			if err != nil {
+				if tc.shouldPass {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+				t.Logf("expected error: %v", err)
+				return
+			}
+
+			if !tc.shouldPass {
+				t.Errorf("expected failure but got watcher: %v", watcher)
+				return
+			}
+
+			if watcher.interval < tc.minInterval {
+				t.Errorf("expected interval of at least %v, got %v", tc.minInterval, watcher.interval)
+				return
+			}
+		})
+	}
+}
+
+func TestDraftWatcher_Diff(t *testing.T) {
+	watcher, err := NewDraftWatcher(testClient, "draft", DraftWatcherOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	now := time.UnixMilli(1_000_000)
+	lastPicked := NewTimestamp(time.UnixMilli(990_000))
+
+	draft := testDraft("snake", 0)
+	draft.Status = "pre_draft"
+	if events := watcher.diff(draft, nil, nil, now); len(events) != 0 {
+		t.Fatalf("expected no events before the draft starts, got %d", len(events))
+	}
+
+	tt := []struct {
+		testcase       string
+		status         string
+		picks          []*DraftPick
+		expectedEvents []draftEventType
+	}{
+		{
+			"draft started",
+			"drafting",
+			nil,
+			[]draftEventType{DraftEventStarted, DraftEventOnTheClock},
+		},
+		{
+			"first pick made",
+			"drafting",
+			[]*DraftPick{{PickNo: 1, Round: 1, DraftSlot: 1}},
+			[]draftEventType{DraftEventPickMade, DraftEventOnTheClock},
+		},
+		{
+			"no change",
+			"drafting",
+			[]*DraftPick{{PickNo: 1, Round: 1, DraftSlot: 1}},
+			nil,
+		},
+		{
+			"draft paused",
+			"paused",
+			[]*DraftPick{{PickNo: 1, Round: 1, DraftSlot: 1}},
+			[]draftEventType{DraftEventPaused},
+		},
+		{
+			"draft resumed",
+			"drafting",
+			[]*DraftPick{{PickNo: 1, Round: 1, DraftSlot: 1}},
+			[]draftEventType{DraftEventResumed},
+		},
+		{
+			"draft completed",
+			"complete",
+			[]*DraftPick{{PickNo: 1, Round: 1, DraftSlot: 1}, {PickNo: 2, Round: 1, DraftSlot: 2}},
+			[]draftEventType{DraftEventCompleted, DraftEventPickMade},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testcase, func(t *testing.T) {
+			next := *draft
+			next.Status = tc.status
+			next.LastPicked = lastPicked
+			next.Settings = &DraftSettings{Teams: 4, Rounds: 4, PickTimer: 60}
+
+			events := watcher.diff(&next, tc.picks, nil, now)
+			if len(events) != len(tc.expectedEvents) {
+				t.Errorf("expected %d events, got %d", len(tc.expectedEvents), len(events))
+				return
+			}
+
+			for i, event := range events {
+				if event.Type != tc.expectedEvents[i] {
+					t.Errorf("expected event %s, got %s", tc.expectedEvents[i], event.Type)
+				}
+				if event.Type == DraftEventOnTheClock {
+					if event.OnClock == nil {
+						t.Errorf("expected on the clock pick")
+					}
+					if expected := lastPicked.Time().Add(time.Minute); !event.Deadline.Equal(expected) {
+						t.Errorf("expected deadline %v, got %v", expected, event.Deadline)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestDraftWatcher_DiffAttached(t *testing.T) {
+	watcher, err := NewDraftWatcher(testClient, "draft", DraftWatcherOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	draft := testDraft("snake", 0)
+	draft.Status = "drafting"
+	picks := []*DraftPick{{PickNo: 1, Round: 1, DraftSlot: 1}}
+	// Slot 2 belongs to roster 3, which traded its first round pick to roster 1.
+	traded := []*TradedDraftPick{{Season: "2025", Round: 1, RosterID: 3, PreviousOwnerID: 3, OwnerID: 1}}
+
+	// Attaching to a running draft reports only the pick on the clock, not the start or past picks.
+	events := watcher.diff(draft, picks, traded, time.UnixMilli(1_000_000))
+	if len(events) != 1 || events[0].Type != DraftEventOnTheClock {
+		t.Fatalf("expected a single on the clock event, got %d events", len(events))
+	}
+	if onClock := events[0].OnClock; onClock.PickNo != 2 || onClock.RosterID != 1 || !onClock.Traded {
+		t.Errorf("expected roster 1 on the clock with traded pick 2, got %+v", onClock)
+	}
+
+	if events := watcher.diff(draft, picks, traded, time.UnixMilli(1_000_000)); len(events) != 0 {
+		t.Errorf("expected no events without changes, got %d", len(events))
+	}
+}
+
+func TestDraftWatcher_Watch(t *testing.T) {
+	watcher, err := NewDraftWatcher(testClient, "draft", DraftWatcherOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// A cancelled context stops the watcher and closes the channel.
+	for range watcher.Watch(ctx) {
+	}
+}
@@ -10,13 +10,13 @@ import (
 
 // Draft represents a draft object in the Sleeper API.
 type Draft struct {
-	Created         int64          `json:"created"`
+	Created         Timestamp      `json:"created"`
 	Creators        []string       `json:"creators"`
 	DraftID         string         `json:"draft_id"`
 	DraftOrder      map[string]int `json:"draft_order"`
 	LastMessageID   string         `json:"last_message_id"`
-	LastMessageTime int64          `json:"last_message_time"`
-	LastPicked      *int64         `json:"last_picked"`
+	LastMessageTime Timestamp      `json:"last_message_time"`
+	LastPicked      Timestamp      `json:"last_picked"`
 	LeagueID        string         `json:"league_id"`
 	Metadata        *DraftMetadata `json:"metadata"`
 	Season          string         `json:"season"`
@@ -24,9 +24,11 @@ type Draft struct {
 	Settings        *DraftSettings `json:"settings"`
 	SlotToRosterID  map[string]int `json:"slot_to_roster_id"`
 	Sport           string         `json:"sport"`
-	StartTime       *int64         `json:"start_time"`
+	StartTime       Timestamp      `json:"start_time"`
 	Status          string         `json:"status"`
 	Type            string         `json:"type"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // DraftMetadata contains metadata for a draft in the Sleeper API.
@@ -38,51 +40,56 @@ type DraftMetadata struct {
 	LeagueType       string `json:"league_type,omitempty"`
 	ElapsedPickTimer string `json:"elapsed_pick_timer,omitempty"`
 	IsAutopaused     string `json:"is_autopaused,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // DraftSettings contains settings for a draft in the Sleeper API.
 type DraftSettings struct {
-	AlphaSort             int `json:"alpha_sort"`
-	AutopauseEnabled      int `json:"autopause_enabled"`
-	AutopauseEndTime      int `json:"autopause_end_time"`
-	AutopauseStartTime    int `json:"autopause_start_time"`
-	Autostart             int `json:"autostart"`
-	CPUAutopick           int `json:"cpu_autopick"`
-	EnforcePositionLimits int `json:"enforce_position_limits"`
-	NominationTimer       int `json:"nomination_timer"`
-	PickTimer             int `json:"pick_timer"`
-	PlayerType            int `json:"player_type"`
-	ReversalRound         int `json:"reversal_round"`
-	Rounds                int `json:"rounds"`
-	Teams                 int `json:"teams"`
+	AlphaSort             FlexInt `json:"alpha_sort"`
+	AutopauseEnabled      FlexInt `json:"autopause_enabled"`
+	AutopauseEndTime      FlexInt `json:"autopause_end_time"`
+	AutopauseStartTime    FlexInt `json:"autopause_start_time"`
+	Autostart             FlexInt `json:"autostart"`
+	Budget                FlexInt `json:"budget,omitempty"` // auction drafts only
+	CPUAutopick           FlexInt `json:"cpu_autopick"`
+	EnforcePositionLimits FlexInt `json:"enforce_position_limits"`
+	NominationTimer       FlexInt `json:"nomination_timer"`
+	PickTimer             FlexInt `json:"pick_timer"`
+	PlayerType            FlexInt `json:"player_type"`
+	ReversalRound         FlexInt `json:"reversal_round"`
+	Rounds                FlexInt `json:"rounds"`
+	Teams                 FlexInt `json:"teams"`
 
 	// Roster slots - NFL
-	SlotsBN        int `json:"slots_bn,omitempty"`
-	SlotsFlex      int `json:"slots_flex,omitempty"`
-	SlotsQB        int `json:"slots_qb,omitempty"`
-	SlotsRB        int `json:"slots_rb,omitempty"`
-	SlotsSuperFlex int `json:"slots_super_flex,omitempty"`
-	SlotsTE        int `json:"slots_te,omitempty"`
-	SlotsWR        int `json:"slots_wr,omitempty"`
-	SlotsDEF       int `json:"slots_def,omitempty"`
-	SlotsK         int `json:"slots_k,omitempty"`
+	SlotsBN        FlexInt `json:"slots_bn,omitempty"`
+	SlotsFlex      FlexInt `json:"slots_flex,omitempty"`
+	SlotsQB        FlexInt `json:"slots_qb,omitempty"`
+	SlotsRB        FlexInt `json:"slots_rb,omitempty"`
+	SlotsSuperFlex FlexInt `json:"slots_super_flex,omitempty"`
+	SlotsTE        FlexInt `json:"slots_te,omitempty"`
+	SlotsWR        FlexInt `json:"slots_wr,omitempty"`
+	SlotsDEF       FlexInt `json:"slots_def,omitempty"`
+	SlotsK         FlexInt `json:"slots_k,omitempty"`
 
 	// Roster slots - NBA
-	SlotsC    int `json:"slots_c,omitempty"`
-	SlotsF    int `json:"slots_f,omitempty"`
-	SlotsG    int `json:"slots_g,omitempty"`
-	SlotsPF   int `json:"slots_pf,omitempty"`
-	SlotsPG   int `json:"slots_pg,omitempty"`
-	SlotsSF   int `json:"slots_sf,omitempty"`
-	SlotsSG   int `json:"slots_sg,omitempty"`
-	SlotsUtil int `json:"slots_util,omitempty"`
+	SlotsC    FlexInt `json:"slots_c,omitempty"`
+	SlotsF    FlexInt `json:"slots_f,omitempty"`
+	SlotsG    FlexInt `json:"slots_g,omitempty"`
+	SlotsPF   FlexInt `json:"slots_pf,omitempty"`
+	SlotsPG   FlexInt `json:"slots_pg,omitempty"`
+	SlotsSF   FlexInt `json:"slots_sf,omitempty"`
+	SlotsSG   FlexInt `json:"slots_sg,omitempty"`
+	SlotsUtil FlexInt `json:"slots_util,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // DraftPick represents a single pick in a draft in the Sleeper API.
 type DraftPick struct {
 	DraftID   string              `json:"draft_id"`
 	DraftSlot int                 `json:"draft_slot"`
-	IsKeeper  interface{}         `json:"is_keeper"`
+	IsKeeper  FlexBool            `json:"is_keeper"` // null in the API for non-keeper picks
 	Metadata  *DraftPickMetadata  `json:"metadata"`
 	PickNo    int                 `json:"pick_no"`
 	PickedBy  string              `json:"picked_by"`
@@ -90,23 +97,28 @@ type DraftPick struct {
 	Reactions map[string][]string `json:"reactions"`
 	RosterID  int                 `json:"roster_id"`
 	Round     int                 `json:"round"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // DraftPickMetadata contains metadata for a draft pick in the Sleeper API.
 type DraftPickMetadata struct {
-	FirstName     string `json:"first_name"`
-	InjuryStatus  string `json:"injury_status"`
-	LastName      string `json:"last_name"`
-	NewsUpdated   string `json:"news_updated"`
-	Number        string `json:"number"`
-	PlayerID      string `json:"player_id"`
-	Position      string `json:"position"`
-	Sport         string `json:"sport"`
-	Status        string `json:"status"`
-	Team          string `json:"team"`
-	TeamAbbr      string `json:"team_abbr"`
-	TeamChangedAt string `json:"team_changed_at"`
-	YearsExp      string `json:"years_exp"`
+	Amount        FlexInt   `json:"amount,omitempty"` // auction price, auction drafts only
+	FirstName     string    `json:"first_name"`
+	InjuryStatus  string    `json:"injury_status"`
+	LastName      string    `json:"last_name"`
+	NewsUpdated   Timestamp `json:"news_updated"`
+	Number        string    `json:"number"` // jersey number, kept as a string so "00" is preserved
+	PlayerID      string    `json:"player_id"`
+	Position      string    `json:"position"`
+	Sport         string    `json:"sport"`
+	Status        string    `json:"status"`
+	Team          string    `json:"team"`
+	TeamAbbr      string    `json:"team_abbr"`
+	TeamChangedAt Timestamp `json:"team_changed_at"`
+	YearsExp      FlexInt   `json:"years_exp"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetDraft retrieves a single draft by draft ID from the Sleeper API.
@@ -125,7 +137,7 @@ func (c *Client) GetDraft(ctx context.Context, draftID string) (*Draft, error) {
 		return nil, fmt.Errorf("getting draft: %w", err)
 	}
 
-	if err := json.Unmarshal(by, &draft); err != nil {
+	if err := c.decode(endpointDraft, by, &draft); err != nil {
 		return nil, fmt.Errorf("unmarshaling draft: %w", err)
 	}
 
@@ -159,7 +171,7 @@ func (c *Client) GetUserDrafts(ctx context.Context, userID string, sport sport,
 		return nil, fmt.Errorf("getting drafts for user: %w", err)
 	}
 
-	if err := json.Unmarshal(by, &drafts); err != nil {
+	if err := c.decode(endpointUserDrafts, by, &drafts); err != nil {
 		return nil, fmt.Errorf("unmarshaling drafts: %w", err)
 	}
 
@@ -182,7 +194,7 @@ func (c *Client) GetLeagueDrafts(ctx context.Context, leagueID string) ([]*Draft
 		return nil, fmt.Errorf("getting drafts for league: %w", err)
 	}
 
-	if err := json.Unmarshal(by, &drafts); err != nil {
+	if err := c.decode(endpointLeagueDrafts, by, &drafts); err != nil {
 		return nil, fmt.Errorf("unmarshaling drafts: %w", err)
 	}
 
@@ -209,7 +221,7 @@ func (c *Client) GetDraftTradedPicks(ctx context.Context, draftID string) ([]*Tr
 		return nil, fmt.Errorf("getting traded picks for draft: %w", err)
 	}
 
-	if err := json.Unmarshal(by, &picks); err != nil {
+	if err := c.decode(endpointDraftTradedPicks, by, &picks); err != nil {
 		return nil, fmt.Errorf("unmarshaling traded picks: %w", err)
 	}
 
@@ -232,7 +244,7 @@ func (c *Client) GetDraftPicks(ctx context.Context, draftID string) ([]*DraftPic
 		return nil, fmt.Errorf("getting picks for draft: %w", err)
 	}
 
-	if err := json.Unmarshal(by, &picks); err != nil {
+	if err := c.decode(endpointDraftPicks, by, &picks); err != nil {
 		return nil, fmt.Errorf("unmarshaling picks: %w", err)
 	}
 
@@ -54,7 +54,7 @@ func TestDraft_Get(t *testing.T) {
 				return
 			}
 
-			if draft.Settings.Rounds != tc.expectedRounds {
+			if draft.Settings.Rounds.Int() != tc.expectedRounds {
 				t.Errorf("expected %d rounds, got %d", tc.expectedRounds, draft.Settings.Rounds)
 				return
 			}
@@ -0,0 +1,190 @@
+package sleeper
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strconv"
+)
+
+type leagueType int
+
+// League types, as in Settings.Type.
+const (
+	LeagueTypeRedraft leagueType = 0
+	LeagueTypeKeeper  leagueType = 1
+	LeagueTypeDynasty leagueType = 2
+)
+
+// String returns the league type's name.
+func (t leagueType) String() string {
+	switch t {
+	case LeagueTypeRedraft:
+		return "redraft"
+	case LeagueTypeKeeper:
+		return "keeper"
+	case LeagueTypeDynasty:
+		return "dynasty"
+	default:
+		return strconv.Itoa(int(t))
+	}
+}
+
+// Type returns the league's type.
+func (l *League) Type() leagueType {
+	if l.Settings == nil {
+		return LeagueTypeRedraft
+	}
+	return leagueType(l.Settings.Type.Int())
+}
+
+// IsBestBall reports whether the league is a best ball league.
+func (l *League) IsBestBall() bool {
+	return l.Settings != nil && l.Settings.BestBall.Int() == 1
+}
+
+// ExposureFilter selects the leagues included in an exposure report. The zero value includes every league.
+type ExposureFilter struct {
+	BestBall *bool        // Only best ball leagues if true, only non-best ball leagues if false
+	Types    []leagueType // Only leagues of these types
+	Statuses []string     // Only leagues with these statuses, e.g. "in_season"
+}
+
+func (f ExposureFilter) matches(l *League) bool {
+	if l == nil {
+		return false
+	}
+	if f.BestBall != nil && l.IsBestBall() != *f.BestBall {
+		return false
+	}
+	if len(f.Types) > 0 && !slices.Contains(f.Types, l.Type()) {
+		return false
+	}
+	if len(f.Statuses) > 0 && !slices.Contains(f.Statuses, l.Status) {
+		return false
+	}
+	return true
+}
+
+// ExposureOptions holds options for GetExposureReport.
+type ExposureOptions struct {
+	Portfolio PortfolioOptions // Sports and seasons to load; rosters are always fetched
+	Filter    ExposureFilter
+}
+
+// PlayerExposure is how many of a user's teams roster a player.
+type PlayerExposure struct {
+	PlayerID string   `json:"player_id"`
+	Count    int      `json:"count"`    // teams rostering the player
+	Starting int      `json:"starting"` // teams starting the player
+	Percent  float64  `json:"percent"`  // Count as a percentage of the report's teams
+	Leagues  []string `json:"leagues"`  // IDs of the leagues rostering the player
+}
+
+// ExposureReport holds a user's exposure to each player across their teams.
+type ExposureReport struct {
+	User    *User             `json:"user,omitempty"`
+	Teams   int               `json:"teams"`   // teams included in the report
+	Players []*PlayerExposure `json:"players"` // most rostered first
+
+	byPlayerID map[string]*PlayerExposure
+}
+
+// NewExposureReport counts the players on the user's roster in each portfolio league matching the filter.
+// Leagues where the user has no roster are skipped.
+func NewExposureReport(portfolio *UserPortfolio, filter ExposureFilter) *ExposureReport {
+	r := &ExposureReport{byPlayerID: make(map[string]*PlayerExposure)}
+	if portfolio == nil {
+		return r
+	}
+	r.User = portfolio.User
+
+	for _, pl := range portfolio.Leagues {
+		if pl.Roster == nil || !filter.matches(pl.League) {
+			continue
+		}
+		r.Teams++
+
+		seen := make(map[string]bool, len(pl.Roster.Players))
+		for _, playerID := range pl.Roster.Players {
+			if playerID == "" || playerID == emptyStarterID || seen[playerID] {
+				continue
+			}
+			seen[playerID] = true
+
+			e := r.byPlayerID[playerID]
+			if e == nil {
+				e = &PlayerExposure{PlayerID: playerID}
+				r.byPlayerID[playerID] = e
+				r.Players = append(r.Players, e)
+			}
+			e.Count++
+			e.Leagues = append(e.Leagues, pl.League.LeagueID)
+			if slices.Contains(pl.Roster.Starters, playerID) {
+				e.Starting++
+			}
+		}
+	}
+
+	for _, e := range r.Players {
+		e.Percent = float64(e.Count) / float64(r.Teams) * 100
+	}
+	sort.Slice(r.Players, func(i, j int) bool {
+		if r.Players[i].Count != r.Players[j].Count {
+			return r.Players[i].Count > r.Players[j].Count
+		}
+		return r.Players[i].PlayerID < r.Players[j].PlayerID
+	})
+	return r
+}
+
+// Exposure returns the exposure to a player, or nil if none of the user's teams roster them.
+func (r *ExposureReport) Exposure(playerID string) *PlayerExposure {
+	return r.byPlayerID[playerID]
+}
+
+// WriteCSV renders the report as CSV with one row per player. players is optional and used for player
+// names, positions and teams.
+func (r *ExposureReport) WriteCSV(w io.Writer, players map[string]Player) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"player_id", "name", "position", "team", "count", "starting", "teams", "percent"}); err != nil {
+		return fmt.Errorf("writing exposure csv: %w", err)
+	}
+	for _, e := range r.Players {
+		player := players[e.PlayerID]
+		var team string
+		if player.Team != nil {
+			team = *player.Team
+		}
+		row := []string{
+			e.PlayerID,
+			playerName(player, e.PlayerID),
+			player.Position,
+			team,
+			strconv.Itoa(e.Count),
+			strconv.Itoa(e.Starting),
+			strconv.Itoa(r.Teams),
+			strconv.FormatFloat(e.Percent, 'f', 1, 64),
+		}
+		if err := cw.Write(row); err != nil {
+			return fmt.Errorf("writing exposure csv: %w", err)
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// GetExposureReport retrieves a user's portfolio and reports their exposure to each player across the
+// leagues matching the filter.
+func (c *Client) GetExposureReport(ctx context.Context, usernameOrID string, options ExposureOptions) (*ExposureReport, error) {
+	options.Portfolio.SkipRosters = false
+	portfolio, err := c.GetUserPortfolio(ctx, usernameOrID, options.Portfolio)
+	if err != nil {
+		return nil, fmt.Errorf("getting exposure report: %w", err)
+	}
+
+	return NewExposureReport(portfolio, options.Filter), nil
+}
@@ -0,0 +1,122 @@
+package sleeper
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+func TestNewExposureReport(t *testing.T) {
+	bestBall := &League{LeagueID: "bb", Status: "in_season", Settings: &Settings{BestBall: 1}}
+	dynasty := &League{LeagueID: "dyn", Status: "in_season", Settings: &Settings{Type: FlexInt(LeagueTypeDynasty)}}
+	redraft := &League{LeagueID: "red", Status: "complete", Settings: &Settings{}}
+	portfolio := &UserPortfolio{
+		User: &User{UserID: "u1"},
+		Leagues: []*PortfolioLeague{
+			{League: bestBall, Roster: &Roster{Players: []string{"4034", "9509"}}},
+			{League: dynasty, Roster: &Roster{Players: []string{"9509", "6794", "9509"}, Starters: []string{"9509", "0"}}},
+			{League: redraft, Roster: &Roster{Players: []string{"9509"}}},
+			{League: &League{LeagueID: "none"}},
+		},
+	}
+
+	tt := []struct {
+		testcase        string
+		filter          ExposureFilter
+		expectedTeams   int
+		expectedCount   int
+		expectedPercent float64
+	}{
+		{testcase: "all leagues", expectedTeams: 3, expectedCount: 3, expectedPercent: 100},
+		{testcase: "best ball only", filter: ExposureFilter{BestBall: boolPtr(true)}, expectedTeams: 1, expectedCount: 1, expectedPercent: 100},
+		{testcase: "exclude best ball", filter: ExposureFilter{BestBall: boolPtr(false)}, expectedTeams: 2, expectedCount: 2, expectedPercent: 100},
+		{testcase: "dynasty only", filter: ExposureFilter{Types: []leagueType{LeagueTypeDynasty}}, expectedTeams: 1, expectedCount: 1, expectedPercent: 100},
+		{testcase: "in season only", filter: ExposureFilter{Statuses: []string{"in_season"}}, expectedTeams: 2, expectedCount: 2, expectedPercent: 100},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testcase, func(t *testing.T) {
+			r := NewExposureReport(portfolio, tc.filter)
+			if r.Teams != tc.expectedTeams {
+				t.Errorf("expected %d teams, got %d", tc.expectedTeams, r.Teams)
+			}
+			e := r.Exposure("9509")
+			if e == nil {
+				t.Fatalf("expected exposure to 9509")
+			}
+			if e.Count != tc.expectedCount {
+				t.Errorf("expected count %d, got %d", tc.expectedCount, e.Count)
+			}
+			if e.Percent != tc.expectedPercent {
+				t.Errorf("expected percent %.1f, got %.1f", tc.expectedPercent, e.Percent)
+			}
+		})
+	}
+
+	r := NewExposureReport(portfolio, ExposureFilter{})
+	if r.Players[0].PlayerID != "9509" {
+		t.Errorf("expected 9509 first, got %s", r.Players[0].PlayerID)
+	}
+	if e := r.Exposure("4034"); e == nil || e.Count != 1 || e.Percent < 33.3 || e.Percent > 33.4 {
+		t.Errorf("expected 4034 on 1 of 3 teams, got %+v", e)
+	}
+	if e := r.Exposure("9509"); e.Starting != 1 {
+		t.Errorf("expected 9509 starting on 1 team, got %d", e.Starting)
+	}
+	if r.Exposure("0") != nil {
+		t.Errorf("expected no exposure to the empty slot placeholder")
+	}
+}
+
+func TestExposureReport_WriteCSV(t *testing.T) {
+	team := "ATL"
+	portfolio := &UserPortfolio{
+		Leagues: []*PortfolioLeague{
+			{League: &League{LeagueID: "l1"}, Roster: &Roster{Players: []string{"9509", "4034"}}},
+			{League: &League{LeagueID: "l2"}, Roster: &Roster{Players: []string{"9509"}}},
+		},
+	}
+	players := map[string]Player{
+		"9509": {FullName: "Bijan Robinson", Position: "RB", Team: &team},
+	}
+
+	var sb strings.Builder
+	if err := NewExposureReport(portfolio, ExposureFilter{}).WriteCSV(&sb, players); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := "player_id,name,position,team,count,starting,teams,percent\n" +
+		"9509,Bijan Robinson,RB,ATL,2,0,2,100.0\n" +
+		"4034,4034,,,1,0,2,50.0\n"
+	if sb.String() != expected {
+		t.Errorf("expected csv:\n%s\ngot:\n%s", expected, sb.String())
+	}
+}
+
+func TestGetExposureReport(t *testing.T) {
+	c, _ := newAPIClient(map[string]string{
+		"/user/alice":               `{"user_id": "u1", "username": "alice"}`,
+		"/user/u1/leagues/nfl/2025": `[{"league_id": "l1", "season": "2025", "settings": {"best_ball": 1}}, {"league_id": "l2", "season": "2025", "settings": {"best_ball": 0}}]`,
+		"/league/l1/rosters":        `[{"roster_id": 1, "owner_id": "u1", "players": ["9509", "4034"]}]`,
+		"/league/l2/rosters":        `[{"roster_id": 2, "owner_id": "u2", "co_owners": ["u1"], "players": ["9509"]}]`,
+	})
+
+	r, err := c.GetExposureReport(context.Background(), "alice", ExposureOptions{
+		Portfolio: PortfolioOptions{FromSeason: 2025, ToSeason: 2025, SkipRosters: true},
+		Filter:    ExposureFilter{BestBall: boolPtr(true)},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if r.Teams != 1 || len(r.Players) != 2 {
+		t.Errorf("expected 2 players on 1 team, got %d players on %d teams", len(r.Players), r.Teams)
+	}
+
+	if _, err := c.GetExposureReport(context.Background(), "", ExposureOptions{}); err == nil {
+		t.Errorf("expected failure for a missing username")
+	}
+}
@@ -0,0 +1,383 @@
+package sleeper
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+)
+
+type awardKind string
+
+const (
+	AwardHighestScore      awardKind = "highest_score"       // highest single-week score
+	AwardMostPointsAgainst awardKind = "most_points_against" // most points scored against a team
+	AwardMostBenchPoints   awardKind = "most_bench_points"   // most points left on the bench
+	AwardBiggestBlowout    awardKind = "biggest_blowout"     // largest margin of victory in a game
+	AwardBestDraftValue    awardKind = "best_draft_value"    // draft pick that most outperformed its draft slot
+)
+
+// awardKinds lists the awards in the order they are reported.
+var awardKinds = []awardKind{
+	AwardHighestScore,
+	AwardMostPointsAgainst,
+	AwardMostBenchPoints,
+	AwardBiggestBlowout,
+	AwardBestDraftValue,
+}
+
+// Award is a record set by a team, in a single season or across a league's history.
+type Award struct {
+	Kind       awardKind `json:"kind"`
+	LeagueID   string    `json:"league_id"`
+	Season     string    `json:"season"`
+	Week       int       `json:"week,omitempty"` // for weekly awards
+	RosterID   int       `json:"roster_id"`
+	UserID     string    `json:"user_id,omitempty"`     // empty for orphaned rosters
+	Value      float64   `json:"value"`                 // points, margin, or for draft value the picks gained over the player's draft slot
+	OpponentID string    `json:"opponent_id,omitempty"` // the losing owner, for blowouts
+	PlayerID   string    `json:"player_id,omitempty"`   // the player drafted, for draft value
+	PickNo     int       `json:"pick_no,omitempty"`     // the pick used, for draft value
+}
+
+// SeasonFinisher is a team's final standing in a season.
+type SeasonFinisher struct {
+	RosterID int     `json:"roster_id"`
+	UserID   string  `json:"user_id,omitempty"` // empty for orphaned rosters
+	Wins     int     `json:"wins"`
+	Losses   int     `json:"losses"`
+	Ties     int     `json:"ties"`
+	Points   float64 `json:"points"`
+}
+
+// HallOfFameSeason holds a season's champion, runner-up, regular season winner and awards.
+type HallOfFameSeason struct {
+	LeagueID            string          `json:"league_id"`
+	Season              string          `json:"season"`
+	Champion            *SeasonFinisher `json:"champion,omitempty"`
+	RunnerUp            *SeasonFinisher `json:"runner_up,omitempty"`
+	RegularSeasonWinner *SeasonFinisher `json:"regular_season_winner,omitempty"` // best record, then most points
+	Awards              []*Award        `json:"awards,omitempty"`
+}
+
+// Award returns the season's award of a kind, or nil.
+func (s *HallOfFameSeason) Award(kind awardKind) *Award {
+	for _, a := range s.Awards {
+		if a.Kind == kind {
+			return a
+		}
+	}
+	return nil
+}
+
+// HallOfFame holds the champions and records of every season of a league.
+type HallOfFame struct {
+	Seasons []*HallOfFameSeason `json:"seasons"` // newest first
+	Awards  []*Award            `json:"awards"`  // all-time records, the best of each kind across seasons
+}
+
+// Award returns the all-time award of a kind, or nil.
+func (h *HallOfFame) Award(kind awardKind) *Award {
+	for _, a := range h.Awards {
+		if a.Kind == kind {
+			return a
+		}
+	}
+	return nil
+}
+
+// Titles returns the seasons an owner won the championship, newest first.
+func (h *HallOfFame) Titles(userID string) []string {
+	var seasons []string
+	for _, s := range h.Seasons {
+		if s.Champion != nil && s.Champion.UserID == userID {
+			seasons = append(seasons, s.Season)
+		}
+	}
+	return seasons
+}
+
+// NewHallOfFame builds a hall of fame from a league's seasons. draftPicks holds each season's draft picks
+// by league ID and is used for the draft value award; it may be nil.
+//
+// Champions and runners-up come from the final of the winners bracket. When a season's bracket has no
+// final result, the champion falls back to the league metadata's latest winner roster ID. Awards cover the
+// regular season only: weeks from the league's playoff week start on, including consolation games, are
+// left out, and points against come from the rosters' regular season totals.
+func NewHallOfFame(seasons []*LeagueSeason, draftPicks map[string][]*DraftPick) *HallOfFame {
+	h := &HallOfFame{}
+	best := make(map[awardKind]*Award)
+	for _, s := range seasons {
+		if s == nil || s.League == nil {
+			continue
+		}
+
+		hs := newHallOfFameSeason(s, draftPicks[s.League.LeagueID])
+		h.Seasons = append(h.Seasons, hs)
+		for _, a := range hs.Awards {
+			if b, ok := best[a.Kind]; !ok || a.Value > b.Value {
+				best[a.Kind] = a
+			}
+		}
+	}
+
+	sort.SliceStable(h.Seasons, func(i, j int) bool {
+		return h.Seasons[i].Season > h.Seasons[j].Season
+	})
+	for _, kind := range awardKinds {
+		if a, ok := best[kind]; ok {
+			h.Awards = append(h.Awards, a)
+		}
+	}
+	return h
+}
+
+// GetHallOfFame retrieves every season of a league, along with each season's draft, and builds its hall
+// of fame.
+func (c *Client) GetHallOfFame(ctx context.Context, leagueID string) (*HallOfFame, error) {
+	seasons, err := c.GetLeagueSeasons(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("getting hall of fame: %w", err)
+	}
+
+	draftPicks := make(map[string][]*DraftPick)
+	for _, s := range seasons {
+		if s.League.DraftID == "" {
+			continue
+		}
+
+		draft, err := c.GetDraft(ctx, s.League.DraftID)
+		if err != nil {
+			return nil, fmt.Errorf("getting hall of fame: %w", err)
+		}
+		if draft == nil || draft.Status != draftStatusComplete {
+			continue
+		}
+
+		picks, err := c.GetDraftPicks(ctx, draft.DraftID)
+		if err != nil {
+			return nil, fmt.Errorf("getting hall of fame: %w", err)
+		}
+		draftPicks[s.League.LeagueID] = picks
+	}
+
+	return NewHallOfFame(seasons, draftPicks), nil
+}
+
+func newHallOfFameSeason(s *LeagueSeason, picks []*DraftPick) *HallOfFameSeason {
+	hs := &HallOfFameSeason{LeagueID: s.League.LeagueID, Season: s.League.Season}
+
+	for _, m := range s.WinnersBracket {
+		if m == nil || m.Placement == nil || *m.Placement != 1 || m.Winner == nil {
+			continue
+		}
+		hs.Champion = newSeasonFinisher(s, *m.Winner)
+		if m.Loser != nil {
+			hs.RunnerUp = newSeasonFinisher(s, *m.Loser)
+		}
+	}
+	if hs.Champion == nil && s.League.Status == leagueStatusComplete {
+		if rosterID := s.League.Metadata.WinnerRosterID(); rosterID > 0 {
+			hs.Champion = newSeasonFinisher(s, rosterID)
+		}
+	}
+	hs.RegularSeasonWinner = regularSeasonWinner(s)
+
+	awards := []*Award{
+		highestScore(s),
+		mostPointsAgainst(s),
+		mostBenchPoints(s),
+		biggestBlowout(s),
+		bestDraftValue(s, picks),
+	}
+	for _, a := range awards {
+		if a != nil {
+			hs.Awards = append(hs.Awards, a)
+		}
+	}
+	return hs
+}
+
+func newSeasonFinisher(s *LeagueSeason, rosterID int) *SeasonFinisher {
+	f := &SeasonFinisher{RosterID: rosterID}
+	for _, r := range s.Rosters {
+		if r == nil || r.RosterID != rosterID {
+			continue
+		}
+		f.UserID = r.OwnerID
+		if r.Settings != nil {
+			f.Wins = r.Settings.Wins.Int()
+			f.Losses = r.Settings.Losses.Int()
+			f.Ties = r.Settings.Ties.Int()
+			f.Points = r.Settings.Points()
+		}
+	}
+	return f
+}
+
+// regularSeasonWinner returns the team with the best regular season record, breaking ties on points.
+func regularSeasonWinner(s *LeagueSeason) *SeasonFinisher {
+	var best *SeasonFinisher
+	for _, r := range s.Rosters {
+		if r == nil {
+			continue
+		}
+		f := newSeasonFinisher(s, r.RosterID)
+		if f.Wins+f.Losses+f.Ties == 0 {
+			continue
+		}
+		if best == nil {
+			best = f
+			continue
+		}
+		fPct, bestPct := 2*f.Wins+f.Ties, 2*best.Wins+best.Ties
+		if fPct > bestPct || (fPct == bestPct && f.Points > best.Points) {
+			best = f
+		}
+	}
+	return best
+}
+
+func (s *LeagueSeason) newAward(kind awardKind, rosterID int, value float64) *Award {
+	return &Award{
+		Kind:     kind,
+		LeagueID: s.League.LeagueID,
+		Season:   s.League.Season,
+		RosterID: rosterID,
+		UserID:   s.Owner(rosterID),
+		Value:    value,
+	}
+}
+
+func highestScore(s *LeagueSeason) *Award {
+	var best *Award
+	for _, week := range s.RegularSeasonWeeks() {
+		for _, m := range s.Matchups[week] {
+			if m == nil {
+				continue
+			}
+			if points := matchupPoints(m); points > 0 && (best == nil || points > best.Value) {
+				best = s.newAward(AwardHighestScore, m.RosterID, points)
+				best.Week = week
+			}
+		}
+	}
+	return best
+}
+
+func mostPointsAgainst(s *LeagueSeason) *Award {
+	var best *Award
+	for _, r := range s.Rosters {
+		if r == nil || r.Settings == nil {
+			continue
+		}
+		if against := r.Settings.PointsAgainst(); against > 0 && (best == nil || against > best.Value) {
+			best = s.newAward(AwardMostPointsAgainst, r.RosterID, against)
+		}
+	}
+	return best
+}
+
+// mostBenchPoints totals each roster's regular season points from players who were not started.
+func mostBenchPoints(s *LeagueSeason) *Award {
+	bench := make(map[int]float64)
+	var rosterIDs []int
+	for _, week := range s.RegularSeasonWeeks() {
+		for _, m := range s.Matchups[week] {
+			if m == nil {
+				continue
+			}
+			if _, ok := bench[m.RosterID]; !ok {
+				rosterIDs = append(rosterIDs, m.RosterID)
+			}
+			bench[m.RosterID] += benchPoints(m)
+		}
+	}
+
+	var best *Award
+	for _, rosterID := range rosterIDs {
+		if points := bench[rosterID]; points > 0 && (best == nil || points > best.Value) {
+			best = s.newAward(AwardMostBenchPoints, rosterID, points)
+		}
+	}
+	return best
+}
+
+func benchPoints(m *Matchup) float64 {
+	var total float64
+	for playerID, points := range m.PlayersPoints {
+		if !slices.Contains(m.Starters, playerID) {
+			total += points
+		}
+	}
+	return total
+}
+
+func biggestBlowout(s *LeagueSeason) *Award {
+	var best *Award
+	for _, week := range s.RegularSeasonWeeks() {
+		for _, game := range s.Games(week) {
+			winner, loser := game[0], game[1]
+			if matchupPoints(loser) > matchupPoints(winner) {
+				winner, loser = loser, winner
+			}
+			margin := matchupPoints(winner) - matchupPoints(loser)
+			if margin > 0 && (best == nil || margin > best.Value) {
+				best = s.newAward(AwardBiggestBlowout, winner.RosterID, margin)
+				best.Week = week
+				best.OpponentID = s.Owner(loser.RosterID)
+			}
+		}
+	}
+	return best
+}
+
+// bestDraftValue ranks the drafted players by their points over the regular season and returns the pick whose
+// player finished furthest ahead of the pick's position. A player's points count only while rostered.
+func bestDraftValue(s *LeagueSeason, picks []*DraftPick) *Award {
+	points := make(map[string]float64)
+	for _, week := range s.RegularSeasonWeeks() {
+		for _, m := range s.Matchups[week] {
+			if m == nil {
+				continue
+			}
+			for playerID, p := range m.PlayersPoints {
+				points[playerID] += p
+			}
+		}
+	}
+
+	var drafted []*DraftPick
+	for _, p := range picks {
+		if p != nil && p.PlayerID != "" && p.PickNo > 0 {
+			drafted = append(drafted, p)
+		}
+	}
+	if len(drafted) == 0 {
+		return nil
+	}
+
+	ranked := make([]*DraftPick, len(drafted))
+	copy(ranked, drafted)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if points[ranked[i].PlayerID] != points[ranked[j].PlayerID] {
+			return points[ranked[i].PlayerID] > points[ranked[j].PlayerID]
+		}
+		return ranked[i].PickNo < ranked[j].PickNo
+	})
+
+	var best *Award
+	for rank, p := range ranked {
+		value := float64(p.PickNo - (rank + 1))
+		if value <= 0 || (best != nil && value <= best.Value) {
+			continue
+		}
+		best = s.newAward(AwardBestDraftValue, p.RosterID, value)
+		if p.PickedBy != "" {
+			best.UserID = p.PickedBy
+		}
+		best.PlayerID = p.PlayerID
+		best.PickNo = p.PickNo
+	}
+	return best
+}
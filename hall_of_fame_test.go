@@ -0,0 +1,171 @@
+package sleeper
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+)
+
+func TestLeagueMetadata_WinnerRosterID(t *testing.T) {
+	tt := []struct {
+		testcase         string
+		data             string
+		expectedRosterID int
+	}{
+		{testcase: "string roster ID", data: `{"league_id": "l1", "metadata": {"latest_league_winner_roster_id": "7"}}`, expectedRosterID: 7},
+		{testcase: "no winner yet", data: `{"league_id": "l1", "metadata": {"auto_continue": "on"}}`},
+		{testcase: "no metadata", data: `{"league_id": "l1"}`},
+		{testcase: "not a number", data: `{"league_id": "l1", "metadata": {"latest_league_winner_roster_id": "x"}}`},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testcase, func(t *testing.T) {
+			var league League
+			if err := json.Unmarshal([]byte(tc.data), &league); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if id := league.Metadata.WinnerRosterID(); id != tc.expectedRosterID {
+				t.Errorf("expected roster %d, got %d", tc.expectedRosterID, id)
+			}
+		})
+	}
+}
+
+func TestNewHallOfFame(t *testing.T) {
+	picks := map[string][]*DraftPick{
+		"l1": {
+			{PickNo: 1, RosterID: 2, PlayerID: "d", PickedBy: "u2"},
+			{PickNo: 2, RosterID: 3, PlayerID: "c"},
+			{PickNo: 3, RosterID: 4, PlayerID: "f", PickedBy: "u4"},
+			{PickNo: 4, RosterID: 1, PlayerID: "a", PickedBy: "u1"},
+		},
+	}
+	type points = map[string]float64
+
+	season2024 := testSeason("l1", "2024", 3, "u1", "u2", "u3", "u4")
+	for i, settings := range []*RosterSettings{
+		{Wins: 2, Fpts: 250, FptsAgainst: 200, FptsAgainstDecimal: 50},
+		{Wins: 2, Fpts: 260, FptsAgainst: 230},
+		{Losses: 2, Fpts: 180, FptsAgainst: 210},
+		{Losses: 2, Fpts: 170, FptsAgainst: 220},
+	} {
+		season2024.Rosters[i].Settings = settings
+	}
+	season2024.Matchups = map[int][]*Matchup{
+		1: {
+			testScoredMatchup(1, 1, points{"a": 150}, points{"b": 30}),
+			testScoredMatchup(3, 1, points{"c": 80}, nil),
+			testScoredMatchup(2, 2, points{"d": 120}, points{"e": 5}),
+			testScoredMatchup(4, 2, points{"f": 110}, nil),
+		},
+		2: {
+			testScoredMatchup(1, 1, points{"a": 100}, points{"b": 20}),
+			testScoredMatchup(4, 1, points{"f": 60}, nil),
+			testScoredMatchup(2, 2, points{"d": 140}, nil),
+			testScoredMatchup(3, 2, points{"c": 100}, nil),
+		},
+		// Playoff and consolation weeks do not count towards awards.
+		3: {
+			testScoredMatchup(1, 1, points{"a": 90}, points{"b": 80}),
+			testScoredMatchup(2, 1, points{"d": 95}, nil),
+			testScoredMatchup(3, 2, points{"c": 300}, nil),
+			testScoredMatchup(4, 2, points{"f": 40}, nil),
+		},
+	}
+	season2024.WinnersBracket = []*PlayoffMatchup{
+		{Round: 1, MatchID: 1, Team1: intPtr(1), Team2: intPtr(2), Winner: intPtr(2), Loser: intPtr(1), Placement: intPtr(1)},
+	}
+
+	season2025 := testSeason("l2", "2025", 0, "u2", "u1")
+	season2025.League.Metadata = &LeagueMetadata{LatestLeagueWinnerRosterID: "2"}
+	season2025.Rosters[0].Settings = &RosterSettings{Wins: 1, Fpts: 190, FptsAgainst: 100}
+	season2025.Rosters[1].Settings = &RosterSettings{Losses: 1, Fpts: 100, FptsAgainst: 190}
+	season2025.Matchups[1] = []*Matchup{testMatchup(1, 1, 190), testMatchup(2, 1, 100)}
+
+	h := NewHallOfFame([]*LeagueSeason{season2024, season2025}, picks)
+
+	if len(h.Seasons) != 2 || h.Seasons[0].Season != "2025" {
+		t.Fatalf("expected 2 seasons newest first, got %d", len(h.Seasons))
+	}
+
+	s2024 := h.Seasons[1]
+	if s2024.Champion == nil || s2024.Champion.UserID != "u2" {
+		t.Errorf("expected u2 to win 2024, got %+v", s2024.Champion)
+	}
+	if s2024.RunnerUp == nil || s2024.RunnerUp.UserID != "u1" {
+		t.Errorf("expected u1 runner-up in 2024, got %+v", s2024.RunnerUp)
+	}
+	if s2024.RegularSeasonWinner == nil || s2024.RegularSeasonWinner.UserID != "u2" {
+		t.Errorf("expected u2 to win the 2024 regular season on points, got %+v", s2024.RegularSeasonWinner)
+	}
+
+	s2025 := h.Seasons[0]
+	if s2025.Champion == nil || s2025.Champion.UserID != "u1" || s2025.RunnerUp != nil {
+		t.Errorf("expected u1 to win 2025 from league metadata, got %+v", s2025.Champion)
+	}
+	if titles := h.Titles("u1"); len(titles) != 1 || titles[0] != "2025" {
+		t.Errorf("expected u1 titles [2025], got %v", titles)
+	}
+
+	tt := []struct {
+		testcase       string
+		kind           awardKind
+		expectedUserID string
+		expectedSeason string
+		expectedValue  float64
+	}{
+		{testcase: "highest score", kind: AwardHighestScore, expectedUserID: "u2", expectedSeason: "2025", expectedValue: 190},
+		{testcase: "most points against", kind: AwardMostPointsAgainst, expectedUserID: "u2", expectedSeason: "2024", expectedValue: 230},
+		{testcase: "most bench points", kind: AwardMostBenchPoints, expectedUserID: "u1", expectedSeason: "2024", expectedValue: 50},
+		{testcase: "biggest blowout", kind: AwardBiggestBlowout, expectedUserID: "u2", expectedSeason: "2025", expectedValue: 90},
+		{testcase: "best draft value", kind: AwardBestDraftValue, expectedUserID: "u1", expectedSeason: "2024", expectedValue: 2},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testcase, func(t *testing.T) {
+			a := h.Award(tc.kind)
+			if a == nil {
+				t.Fatalf("expected a %s award", tc.kind)
+			}
+			if a.UserID != tc.expectedUserID || a.Season != tc.expectedSeason || a.Value != tc.expectedValue {
+				t.Errorf("expected %s in %s with %v, got %s in %s with %v", tc.expectedUserID, tc.expectedSeason, tc.expectedValue, a.UserID, a.Season, a.Value)
+			}
+		})
+	}
+
+	if a := h.Award(AwardBiggestBlowout); a.OpponentID != "u1" || a.Week != 1 {
+		t.Errorf("expected the blowout against u1 in week 1, got %+v", a)
+	}
+	if a := h.Award(AwardBestDraftValue); a.PlayerID != "a" || a.PickNo != 4 {
+		t.Errorf("expected player a at pick 4, got %+v", a)
+	}
+	if a := s2024.Award(AwardHighestScore); a == nil || a.Value != 150 || a.Week != 1 {
+		t.Errorf("expected a 2024 high score of 150 in week 1, got %+v", a)
+	}
+}
+
+func TestGetHallOfFame(t *testing.T) {
+	c, _ := newAPIClient(map[string]string{
+		"/league/l1":                 `{"league_id": "l1", "season": "2025", "status": "complete", "draft_id": "d1", "settings": {"last_scored_leg": 1, "playoff_week_start": 2}, "metadata": {"latest_league_winner_roster_id": "1"}}`,
+		"/league/l1/rosters":         `[{"roster_id": 1, "owner_id": "u1", "settings": {"wins": 1}}, {"roster_id": 2, "owner_id": "u2", "settings": {"losses": 1}}]`,
+		"/league/l1/winners_bracket": `[]`,
+		"/league/l1/matchups/1":      `[{"roster_id": 1, "matchup_id": 1, "points": 120, "starters": ["a"], "players_points": {"a": 120}}, {"roster_id": 2, "matchup_id": 1, "points": 90, "starters": ["b"], "players_points": {"b": 90}}]`,
+		"/draft/d1":                  `{"draft_id": "d1", "status": "complete"}`,
+		"/draft/d1/picks":            `[{"pick_no": 1, "roster_id": 2, "player_id": "b", "picked_by": "u2"}, {"pick_no": 2, "roster_id": 1, "player_id": "a", "picked_by": "u1"}]`,
+	})
+
+	h, err := c.GetHallOfFame(context.Background(), "l1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(h.Seasons) != 1 || h.Seasons[0].Champion == nil || h.Seasons[0].Champion.UserID != "u1" {
+		t.Fatalf("expected u1 champion, got %+v", h.Seasons)
+	}
+	if a := h.Award(AwardBestDraftValue); a == nil || a.PlayerID != "a" || a.Value != 1 {
+		t.Errorf("expected player a as the best draft value, got %+v", a)
+	}
+
+	if _, err := c.GetHallOfFame(context.Background(), "missing"); err == nil {
+		t.Errorf("expected failure for a missing league")
+	}
+}
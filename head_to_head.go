@@ -0,0 +1,266 @@
+package sleeper
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// HeadToHeadMeeting is a single game between two owners.
+type HeadToHeadMeeting struct {
+	LeagueID string  `json:"league_id"`
+	Season   string  `json:"season"`
+	Week     int     `json:"week"`            // the first week of the game
+	Round    int     `json:"round,omitempty"` // winners bracket round, for playoff games
+	Playoff  bool    `json:"playoff"`
+	UserA    string  `json:"user_a"`
+	UserB    string  `json:"user_b"`
+	PointsA  float64 `json:"points_a"`
+	PointsB  float64 `json:"points_b"`
+	WinnerID string  `json:"winner_id,omitempty"` // empty for ties
+}
+
+// Margin returns the winner's margin of victory.
+func (m *HeadToHeadMeeting) Margin() float64 {
+	return math.Abs(m.PointsA - m.PointsB)
+}
+
+func (m *HeadToHeadMeeting) swapped() *HeadToHeadMeeting {
+	s := *m
+	s.UserA, s.UserB = m.UserB, m.UserA
+	s.PointsA, s.PointsB = m.PointsB, m.PointsA
+	return &s
+}
+
+// HeadToHeadRecord is the all-time record between two owners, from UserA's point of view.
+type HeadToHeadRecord struct {
+	UserA          string               `json:"user_a"`
+	UserB          string               `json:"user_b"`
+	WinsA          int                  `json:"wins_a"`
+	WinsB          int                  `json:"wins_b"`
+	Ties           int                  `json:"ties"`
+	PlayoffWinsA   int                  `json:"playoff_wins_a"`
+	PlayoffWinsB   int                  `json:"playoff_wins_b"`
+	PointsA        float64              `json:"points_a"`
+	PointsB        float64              `json:"points_b"`
+	AverageMargin  float64              `json:"average_margin"`   // average margin of victory, ignoring ties
+	LongestStreakA int                  `json:"longest_streak_a"` // most consecutive wins by UserA
+	LongestStreakB int                  `json:"longest_streak_b"` // most consecutive wins by UserB
+	CurrentStreak  int                  `json:"current_streak"`   // consecutive wins ending with the latest meeting, positive for UserA and negative for UserB
+	Meetings       []*HeadToHeadMeeting `json:"meetings"`         // oldest first
+}
+
+// Games returns the number of meetings.
+func (r *HeadToHeadRecord) Games() int {
+	return len(r.Meetings)
+}
+
+// newHeadToHeadRecord totals meetings, which must be oriented for userA and in chronological order.
+func newHeadToHeadRecord(userA, userB string, meetings []*HeadToHeadMeeting) *HeadToHeadRecord {
+	r := &HeadToHeadRecord{UserA: userA, UserB: userB, Meetings: meetings}
+
+	var margins float64
+	var streakA, streakB int
+	for _, m := range meetings {
+		r.PointsA += m.PointsA
+		r.PointsB += m.PointsB
+
+		switch m.WinnerID {
+		case userA:
+			r.WinsA++
+			if m.Playoff {
+				r.PlayoffWinsA++
+			}
+			margins += m.Margin()
+			streakA, streakB = streakA+1, 0
+		case userB:
+			r.WinsB++
+			if m.Playoff {
+				r.PlayoffWinsB++
+			}
+			margins += m.Margin()
+			streakA, streakB = 0, streakB+1
+		default:
+			r.Ties++
+			streakA, streakB = 0, 0
+		}
+		r.LongestStreakA = max(r.LongestStreakA, streakA)
+		r.LongestStreakB = max(r.LongestStreakB, streakB)
+	}
+
+	if decided := r.WinsA + r.WinsB; decided > 0 {
+		r.AverageMargin = margins / float64(decided)
+	}
+	r.CurrentStreak = streakA - streakB
+	return r
+}
+
+// HeadToHead holds the all-time records between every pair of owners in a league's history. Owners are
+// identified by user ID, so records follow owners across seasons even when roster IDs are reassigned.
+type HeadToHead struct {
+	Records []*HeadToHeadRecord `json:"records"` // most meetings first
+
+	byPair map[[2]string]*HeadToHeadRecord
+}
+
+// NewHeadToHead collects every meeting between owners across seasons. Regular season games are taken
+// from the weekly matchups and playoff games from the winners bracket, with points summed over the weeks
+// of the round. Consolation games, including winners bracket games for places other than first, and games
+// involving orphaned rosters are ignored.
+func NewHeadToHead(seasons []*LeagueSeason) *HeadToHead {
+	var meetings []*HeadToHeadMeeting
+	for _, s := range seasons {
+		if s != nil && s.League != nil {
+			meetings = append(meetings, seasonMeetings(s)...)
+		}
+	}
+	sort.SliceStable(meetings, func(i, j int) bool {
+		if meetings[i].Season != meetings[j].Season {
+			return meetings[i].Season < meetings[j].Season
+		}
+		return meetings[i].Week < meetings[j].Week
+	})
+
+	byPair := make(map[[2]string][]*HeadToHeadMeeting)
+	var pairs [][2]string
+	for _, m := range meetings {
+		if m.UserA > m.UserB {
+			m = m.swapped()
+		}
+		pair := [2]string{m.UserA, m.UserB}
+		if _, ok := byPair[pair]; !ok {
+			pairs = append(pairs, pair)
+		}
+		byPair[pair] = append(byPair[pair], m)
+	}
+
+	h := &HeadToHead{byPair: make(map[[2]string]*HeadToHeadRecord, len(pairs))}
+	for _, pair := range pairs {
+		r := newHeadToHeadRecord(pair[0], pair[1], byPair[pair])
+		h.Records = append(h.Records, r)
+		h.byPair[pair] = r
+	}
+	sortHeadToHeadRecords(h.Records)
+	return h
+}
+
+// Record returns the record between two owners from userA's point of view, or nil if they never met.
+func (h *HeadToHead) Record(userA, userB string) *HeadToHeadRecord {
+	if userA <= userB {
+		return h.byPair[[2]string{userA, userB}]
+	}
+	r, ok := h.byPair[[2]string{userB, userA}]
+	if !ok {
+		return nil
+	}
+
+	meetings := make([]*HeadToHeadMeeting, len(r.Meetings))
+	for i, m := range r.Meetings {
+		meetings[i] = m.swapped()
+	}
+	return newHeadToHeadRecord(userA, userB, meetings)
+}
+
+// Rivalries returns an owner's records against every owner they have met, from their point of view,
+// most meetings first.
+func (h *HeadToHead) Rivalries(userID string) []*HeadToHeadRecord {
+	var records []*HeadToHeadRecord
+	for _, r := range h.Records {
+		switch userID {
+		case r.UserA:
+			records = append(records, r)
+		case r.UserB:
+			records = append(records, h.Record(r.UserB, r.UserA))
+		}
+	}
+	sortHeadToHeadRecords(records)
+	return records
+}
+
+// GetHeadToHead retrieves every season of a league and computes the all-time head-to-head records between
+// its owners.
+func (c *Client) GetHeadToHead(ctx context.Context, leagueID string) (*HeadToHead, error) {
+	seasons, err := c.GetLeagueSeasons(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("getting head to head: %w", err)
+	}
+
+	return NewHeadToHead(seasons), nil
+}
+
+// seasonMeetings returns a season's regular season and winners bracket games between owners.
+func seasonMeetings(s *LeagueSeason) []*HeadToHeadMeeting {
+	var meetings []*HeadToHeadMeeting
+	for _, week := range s.RegularSeasonWeeks() {
+		for _, game := range s.Games(week) {
+			a, b := game[0], game[1]
+			pointsA, pointsB := matchupPoints(a), matchupPoints(b)
+			if pointsA == 0 && pointsB == 0 {
+				continue // not played yet
+			}
+			m := newMeeting(s, week, s.Owner(a.RosterID), s.Owner(b.RosterID), pointsA, pointsB)
+			if m == nil {
+				continue
+			}
+			switch {
+			case pointsA > pointsB:
+				m.WinnerID = m.UserA
+			case pointsB > pointsA:
+				m.WinnerID = m.UserB
+			}
+			meetings = append(meetings, m)
+		}
+	}
+
+	for _, pm := range s.WinnersBracket {
+		if pm == nil || pm.Team1 == nil || pm.Team2 == nil || pm.Winner == nil {
+			continue
+		}
+		if pm.Placement != nil && *pm.Placement != 1 {
+			continue // third place and other placement games
+		}
+		weeks := s.PlayoffRoundWeeks(pm.Round)
+		if len(weeks) == 0 {
+			continue
+		}
+		m := newMeeting(s, weeks[0], s.Owner(*pm.Team1), s.Owner(*pm.Team2), s.RosterPoints(*pm.Team1, weeks...), s.RosterPoints(*pm.Team2, weeks...))
+		if m == nil {
+			continue
+		}
+		m.Playoff = true
+		m.Round = pm.Round
+		m.WinnerID = s.Owner(*pm.Winner)
+		meetings = append(meetings, m)
+	}
+	return meetings
+}
+
+// newMeeting returns a meeting between two owners, or nil if either roster is orphaned or both rosters
+// belong to the same owner.
+func newMeeting(s *LeagueSeason, week int, userA, userB string, pointsA, pointsB float64) *HeadToHeadMeeting {
+	if userA == "" || userB == "" || userA == userB {
+		return nil
+	}
+	return &HeadToHeadMeeting{
+		LeagueID: s.League.LeagueID,
+		Season:   s.League.Season,
+		Week:     week,
+		UserA:    userA,
+		UserB:    userB,
+		PointsA:  pointsA,
+		PointsB:  pointsB,
+	}
+}
+
+func sortHeadToHeadRecords(records []*HeadToHeadRecord) {
+	sort.SliceStable(records, func(i, j int) bool {
+		if records[i].Games() != records[j].Games() {
+			return records[i].Games() > records[j].Games()
+		}
+		if records[i].UserA != records[j].UserA {
+			return records[i].UserA < records[j].UserA
+		}
+		return records[i].UserB < records[j].UserB
+	})
+}
@@ -0,0 +1,131 @@
+package sleeper
+
+import (
+	"context"
+	"testing"
+)
+
+func TestNewHeadToHead(t *testing.T) {
+	// u1 and u2 swap roster IDs between seasons.
+	s2025 := testSeason("l2", "2025", 3, "u2", "u1", "u3", "")
+	s2025.Matchups = map[int][]*Matchup{
+		1: {testMatchup(1, 1, 120), testMatchup(2, 1, 100), testMatchup(3, 2, 90), testMatchup(4, 2, 80)},
+		2: {testMatchup(1, 1, 0), testMatchup(2, 1, 0)},
+		3: {testMatchup(1, 1, 110), testMatchup(2, 1, 115), testMatchup(3, 2, 70), testMatchup(4, 2, 60)},
+		4: {testMatchup(1, 1, 100), testMatchup(3, 1, 120)},
+	}
+	s2025.WinnersBracket = []*PlayoffMatchup{
+		{Round: 1, MatchID: 1, Team1: intPtr(1), Team2: intPtr(2), Winner: intPtr(2), Loser: intPtr(1)},
+		{Round: 2, MatchID: 2, Team1: intPtr(3), Team2: intPtr(1), Winner: intPtr(3), Loser: intPtr(1), Placement: intPtr(3)},
+	}
+
+	s2024 := testSeason("l1", "2024", 3, "u1", "u2", "u3")
+	s2024.League.Settings.PlayoffRoundType = playoffRoundsTwoWeeks
+	s2024.Matchups = map[int][]*Matchup{
+		1: {testMatchup(1, 1, 130), testMatchup(2, 1, 100)},
+		2: {testMatchup(1, 1, 95), testMatchup(2, 1, 95), testMatchup(3, 2, 100), testMatchup(2, 3, 50)},
+		3: {testMatchup(1, 1, 100), testMatchup(3, 1, 90)},
+		4: {testMatchup(1, 1, 90), testMatchup(3, 1, 120)},
+	}
+	s2024.WinnersBracket = []*PlayoffMatchup{
+		{Round: 1, MatchID: 1, Team1: intPtr(1), Team2: intPtr(3), Winner: intPtr(3), Loser: intPtr(1)},
+	}
+
+	h := NewHeadToHead([]*LeagueSeason{s2025, s2024})
+
+	tt := []struct {
+		testcase       string
+		userA, userB   string
+		expectedGames  int
+		expectedWinsA  int
+		expectedWinsB  int
+		expectedTies   int
+		expectedMargin float64
+		expectedStreak int
+	}{
+		{testcase: "owners across reassigned rosters", userA: "u1", userB: "u2", expectedGames: 4, expectedWinsA: 2, expectedWinsB: 1, expectedTies: 1, expectedMargin: 18.333333333333332, expectedStreak: 1},
+		{testcase: "reversed", userA: "u2", userB: "u1", expectedGames: 4, expectedWinsA: 1, expectedWinsB: 2, expectedTies: 1, expectedMargin: 18.333333333333332, expectedStreak: -1},
+		{testcase: "playoff meeting over two weeks", userA: "u3", userB: "u1", expectedGames: 1, expectedWinsA: 1, expectedMargin: 20, expectedStreak: 1},
+		{testcase: "third place game ignored", userA: "u2", userB: "u3"},
+		{testcase: "never met", userA: "u1", userB: "u4"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testcase, func(t *testing.T) {
+			r := h.Record(tc.userA, tc.userB)
+			if tc.expectedGames == 0 {
+				if r != nil {
+					t.Errorf("expected no record, got %+v", r)
+				}
+				return
+			}
+			if r == nil {
+				t.Fatalf("expected a record")
+			}
+			if r.UserA != tc.userA || r.UserB != tc.userB {
+				t.Errorf("expected record for %s vs %s, got %s vs %s", tc.userA, tc.userB, r.UserA, r.UserB)
+			}
+			if r.Games() != tc.expectedGames {
+				t.Errorf("expected %d games, got %d", tc.expectedGames, r.Games())
+			}
+			if r.WinsA != tc.expectedWinsA || r.WinsB != tc.expectedWinsB || r.Ties != tc.expectedTies {
+				t.Errorf("expected %d-%d-%d, got %d-%d-%d", tc.expectedWinsA, tc.expectedWinsB, tc.expectedTies, r.WinsA, r.WinsB, r.Ties)
+			}
+			if r.AverageMargin != tc.expectedMargin {
+				t.Errorf("expected average margin %v, got %v", tc.expectedMargin, r.AverageMargin)
+			}
+			if r.CurrentStreak != tc.expectedStreak {
+				t.Errorf("expected current streak %d, got %d", tc.expectedStreak, r.CurrentStreak)
+			}
+		})
+	}
+
+	r := h.Record("u1", "u2")
+	if r.PlayoffWinsA != 1 || r.PlayoffWinsB != 0 {
+		t.Errorf("expected u1 to win the playoff meeting, got %d-%d", r.PlayoffWinsA, r.PlayoffWinsB)
+	}
+	if r.LongestStreakA != 1 || r.LongestStreakB != 1 {
+		t.Errorf("expected longest streaks of 1, got %d and %d", r.LongestStreakA, r.LongestStreakB)
+	}
+	if r.Meetings[0].Season != "2024" || !r.Meetings[3].Playoff {
+		t.Errorf("expected meetings oldest first ending with the playoff game")
+	}
+
+	rivalries := h.Rivalries("u1")
+	if len(rivalries) != 2 || rivalries[0].UserB != "u2" || rivalries[1].UserB != "u3" {
+		t.Errorf("expected rivalries with u2 then u3, got %+v", rivalries)
+	}
+	if rivalries[1].UserA != "u1" || rivalries[1].WinsB != 1 {
+		t.Errorf("expected u1's view of the u3 rivalry, got %+v", rivalries[1])
+	}
+}
+
+func TestGetHeadToHead(t *testing.T) {
+	c, _ := newAPIClient(map[string]string{
+		"/league/l2":                 `{"league_id": "l2", "season": "2025", "status": "complete", "previous_league_id": "l1", "settings": {"last_scored_leg": 2, "playoff_week_start": 2}}`,
+		"/league/l1":                 `{"league_id": "l1", "season": "2024", "status": "complete", "settings": {"last_scored_leg": 1}}`,
+		"/league/l2/rosters":         `[{"roster_id": 1, "owner_id": "u2"}, {"roster_id": 2, "owner_id": "u1"}]`,
+		"/league/l1/rosters":         `[{"roster_id": 1, "owner_id": "u1"}, {"roster_id": 2, "owner_id": "u2"}]`,
+		"/league/l2/winners_bracket": `[{"r": 1, "m": 1, "t1": 1, "t2": 2, "w": 1, "l": 2}]`,
+		"/league/l1/winners_bracket": `[]`,
+		"/league/l2/matchups/1":      `[{"roster_id": 1, "matchup_id": 1, "points": 80}, {"roster_id": 2, "matchup_id": 1, "points": 100}]`,
+		"/league/l2/matchups/2":      `[{"roster_id": 1, "matchup_id": 1, "points": 110}, {"roster_id": 2, "matchup_id": 1, "points": 105}]`,
+		"/league/l1/matchups/1":      `[{"roster_id": 1, "matchup_id": 1, "points": 90}, {"roster_id": 2, "matchup_id": 1, "points": 70}]`,
+	})
+
+	h, err := c.GetHeadToHead(context.Background(), "l2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	r := h.Record("u1", "u2")
+	if r == nil || r.Games() != 3 || r.WinsA != 2 || r.WinsB != 1 || r.PlayoffWinsB != 1 {
+		t.Errorf("expected u1 2-1 against u2 with a playoff loss, got %+v", r)
+	}
+	if r != nil && r.LongestStreakA != 2 {
+		t.Errorf("expected a longest streak of 2, got %d", r.LongestStreakA)
+	}
+
+	if _, err := c.GetHeadToHead(context.Background(), "missing"); err == nil {
+		t.Errorf("expected failure for a missing league")
+	}
+}
@@ -0,0 +1,298 @@
+package sleeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// KeeperRules configures how keeper eligibility and costs are determined.
+type KeeperRules struct {
+	MaxKeepers        int // Maximum keepers per roster (default: the league's max_keepers setting)
+	RoundPenalty      int // Rounds earlier than the drafted round a keeper costs, e.g. 1 to keep a 5th rounder in the 4th
+	EscalationPerYear int // Additional rounds added to the penalty for each consecutive season already kept, counted from the round of the player's last non-keeper pick
+	UndraftedRound    int // Cost of keeping an undrafted player (default: the last round of the draft)
+	MaxYearsKept      int // Consecutive seasons a player may be kept before becoming ineligible (0: unlimited)
+}
+
+// KeeperSeason holds a completed draft from a season of a league's history.
+type KeeperSeason struct {
+	Season string
+	Draft  *Draft
+	Picks  []*DraftPick
+}
+
+// KeeperCandidate describes a single rostered player's keeper eligibility and cost.
+type KeeperCandidate struct {
+	PlayerID     string `json:"player_id"`
+	Eligible     bool   `json:"eligible"`
+	Reason       string `json:"reason,omitempty"`        // why the player is ineligible
+	DraftedRound int    `json:"drafted_round,omitempty"` // round drafted in the most recent draft, 0 if undrafted
+	YearsKept    int    `json:"years_kept"`              // consecutive seasons the player has been kept
+	CostRound    int    `json:"cost_round,omitempty"`    // round the keeper costs in the upcoming draft
+	Selected     bool   `json:"selected"`                // the player is in the roster's keepers
+}
+
+// RosterKeepers holds the keeper candidates for a single roster.
+type RosterKeepers struct {
+	RosterID   int                `json:"roster_id"`
+	OwnerID    string             `json:"owner_id,omitempty"`
+	MaxKeepers int                `json:"max_keepers"`
+	Selected   int                `json:"selected"`
+	OverLimit  bool               `json:"over_limit"` // more keepers selected than allowed
+	Candidates []*KeeperCandidate `json:"candidates"` // eligible players first, cheapest (latest round) first
+}
+
+// Eligible returns the candidates that may be kept.
+func (r *RosterKeepers) Eligible() []*KeeperCandidate {
+	var eligible []*KeeperCandidate
+	for _, c := range r.Candidates {
+		if c.Eligible {
+			eligible = append(eligible, c)
+		}
+	}
+	return eligible
+}
+
+// KeeperReport lists keeper eligibility and costs for every roster ahead of a league's upcoming draft.
+type KeeperReport struct {
+	LeagueID string           `json:"league_id"`
+	Season   string           `json:"season"`             // season of the upcoming draft
+	Deadline string           `json:"deadline,omitempty"` // league keeper deadline, if set
+	Rosters  []*RosterKeepers `json:"rosters"`
+}
+
+// Roster returns the keepers for a roster, or nil.
+func (r *KeeperReport) Roster(rosterID int) *RosterKeepers {
+	for _, rk := range r.Rosters {
+		if rk.RosterID == rosterID {
+			return rk
+		}
+	}
+	return nil
+}
+
+// NewKeeperReport determines which players each roster may keep and what they cost. Seasons holds the
+// league's completed drafts, newest first; the first is used for draft rounds and all are used to count
+// consecutive seasons kept.
+func NewKeeperReport(league *League, rosters []*Roster, seasons []*KeeperSeason, rules KeeperRules) (*KeeperReport, error) {
+	if league == nil {
+		return nil, errors.New("league is required")
+	}
+	if err := rules.validate(); err != nil {
+		return nil, fmt.Errorf("invalid keeper rules: %w", err)
+	}
+
+	if rules.MaxKeepers == 0 && league.Settings != nil {
+		rules.MaxKeepers = league.Settings.MaxKeepers.Int()
+	}
+	if rules.UndraftedRound == 0 {
+		rules.UndraftedRound = keeperLastRound(league, seasons)
+	}
+
+	report := &KeeperReport{
+		LeagueID: league.LeagueID,
+		Season:   keeperSeason(league),
+	}
+	if league.Metadata != nil {
+		report.Deadline = league.Metadata.KeeperDeadline
+	}
+
+	// Round drafted in the most recent draft, the round of the last non-keeper pick and consecutive seasons
+	// kept counting back from it.
+	var picks []map[string]*DraftPick
+	for _, season := range seasons {
+		if season == nil {
+			continue
+		}
+		byPlayer := make(map[string]*DraftPick)
+		for _, pick := range season.Picks {
+			if pick != nil && pick.PlayerID != "" {
+				byPlayer[pick.PlayerID] = pick
+			}
+		}
+		picks = append(picks, byPlayer)
+	}
+	drafted := make(map[string]int)
+	baseRound := make(map[string]int)
+	yearsKept := make(map[string]int)
+	if len(picks) > 0 {
+		for playerID, pick := range picks[0] {
+			drafted[playerID] = pick.Round
+			baseRound[playerID], yearsKept[playerID] = keeperBaseRound(picks, playerID, rules.RoundPenalty)
+		}
+	}
+
+	for _, roster := range rosters {
+		if roster == nil {
+			continue
+		}
+
+		selected := make(map[string]bool)
+		for _, playerID := range roster.Keepers {
+			selected[playerID] = true
+		}
+
+		rk := &RosterKeepers{
+			RosterID:   roster.RosterID,
+			OwnerID:    roster.OwnerID,
+			MaxKeepers: rules.MaxKeepers,
+			Selected:   len(roster.Keepers),
+		}
+		rk.OverLimit = rules.MaxKeepers > 0 && rk.Selected > rules.MaxKeepers
+
+		for _, playerID := range roster.Players {
+			rk.Candidates = append(rk.Candidates, rules.candidate(playerID, drafted[playerID], baseRound[playerID], yearsKept[playerID], selected[playerID]))
+		}
+		sort.SliceStable(rk.Candidates, func(i, j int) bool {
+			a, b := rk.Candidates[i], rk.Candidates[j]
+			if a.Eligible != b.Eligible {
+				return a.Eligible
+			}
+			return a.CostRound > b.CostRound
+		})
+
+		report.Rosters = append(report.Rosters, rk)
+	}
+
+	sort.Slice(report.Rosters, func(i, j int) bool {
+		return report.Rosters[i].RosterID < report.Rosters[j].RosterID
+	})
+	return report, nil
+}
+
+// keeperBaseRound walks a player's picks from the most recent draft back while they were keepers and returns
+// the round of the player's last non-keeper pick along with the consecutive seasons kept since. Keeper
+// rounds already include the penalties, so they cannot be used as the base. If every pick in the history is
+// a keeper pick, the oldest one is used with the round penalty added back.
+func keeperBaseRound(picks []map[string]*DraftPick, playerID string, roundPenalty int) (int, int) {
+	var base, yearsKept int
+	for _, byPlayer := range picks {
+		pick := byPlayer[playerID]
+		if pick == nil {
+			break
+		}
+		if !pick.IsKeeper {
+			return pick.Round, yearsKept
+		}
+		yearsKept++
+		base = pick.Round + roundPenalty
+	}
+	return base, yearsKept
+}
+
+// candidate determines the eligibility and cost of keeping a single player. The cost is based on baseRound,
+// the round of the player's last non-keeper pick.
+func (r KeeperRules) candidate(playerID string, draftedRound, baseRound, yearsKept int, selected bool) *KeeperCandidate {
+	c := &KeeperCandidate{
+		PlayerID:     playerID,
+		Eligible:     true,
+		DraftedRound: draftedRound,
+		YearsKept:    yearsKept,
+		Selected:     selected,
+	}
+
+	if r.MaxYearsKept > 0 && yearsKept >= r.MaxYearsKept {
+		c.Eligible = false
+		c.Reason = fmt.Sprintf("kept %d consecutive seasons (max %d)", yearsKept, r.MaxYearsKept)
+	}
+
+	if draftedRound == 0 {
+		c.CostRound = r.UndraftedRound
+		return c
+	}
+
+	c.CostRound = baseRound - r.RoundPenalty - r.EscalationPerYear*yearsKept
+	if c.CostRound < 1 {
+		c.CostRound = 1
+	}
+	return c
+}
+
+func (r *KeeperRules) validate() error {
+	var errs []string
+	if r.MaxKeepers < 0 {
+		errs = append(errs, "MaxKeepers must be greater than or equal to zero")
+	}
+	if r.RoundPenalty < 0 {
+		errs = append(errs, "RoundPenalty must be greater than or equal to zero")
+	}
+	if r.EscalationPerYear < 0 {
+		errs = append(errs, "EscalationPerYear must be greater than or equal to zero")
+	}
+	if r.UndraftedRound < 0 {
+		errs = append(errs, "UndraftedRound must be greater than or equal to zero")
+	}
+	if r.MaxYearsKept < 0 {
+		errs = append(errs, "MaxYearsKept must be greater than or equal to zero")
+	}
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+// keeperLastRound returns the number of rounds in the league's drafts.
+func keeperLastRound(league *League, seasons []*KeeperSeason) int {
+	if league.Settings != nil && league.Settings.DraftRounds > 0 {
+		return league.Settings.DraftRounds.Int()
+	}
+	for _, s := range seasons {
+		if s != nil && s.Draft != nil && s.Draft.Settings != nil && s.Draft.Settings.Rounds > 0 {
+			return s.Draft.Settings.Rounds.Int()
+		}
+	}
+	return 0
+}
+
+// keeperSeason returns the season of the league's upcoming draft.
+func keeperSeason(league *League) string {
+	if league.Status == leagueStatusPreDraft || league.Status == leagueStatusDrafting {
+		return league.Season
+	}
+	season, err := strconv.Atoi(league.Season)
+	if err != nil {
+		return league.Season
+	}
+	return strconv.Itoa(season + 1)
+}
+
+// GetKeeperReport retrieves a league's rosters and the completed drafts across its history, and reports
+// which players each roster may keep and their costs in the upcoming draft.
+func (c *Client) GetKeeperReport(ctx context.Context, leagueID string, rules KeeperRules) (*KeeperReport, error) {
+	leagues, err := c.GetLeagueHistory(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("getting keeper report: %w", err)
+	}
+
+	rosters, err := c.GetLeagueRosters(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("getting keeper report: %w", err)
+	}
+
+	var seasons []*KeeperSeason
+	for _, league := range leagues {
+		if league.DraftID == "" {
+			continue
+		}
+
+		draft, err := c.GetDraft(ctx, league.DraftID)
+		if err != nil {
+			return nil, fmt.Errorf("getting keeper report: %w", err)
+		}
+		if draft == nil || draft.Status != draftStatusComplete {
+			continue
+		}
+
+		picks, err := c.GetDraftPicks(ctx, draft.DraftID)
+		if err != nil {
+			return nil, fmt.Errorf("getting keeper report: %w", err)
+		}
+		seasons = append(seasons, &KeeperSeason{Season: league.Season, Draft: draft, Picks: picks})
+	}
+
+	return NewKeeperReport(leagues[0], rosters, seasons, rules)
+}
@@ -0,0 +1,186 @@
+package sleeper
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestKeeper_IsKeeperDecoding(t *testing.T) {
+	tt := []struct {
+		testcase string
+		data     string
+		expected bool
+	}{
+		{"null", `{"is_keeper": null}`, false},
+		{"true", `{"is_keeper": true}`, true},
+		{"missing", `{}`, false},
+		{"string", `{"is_keeper": "true"}`, true},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testcase, func(t *testing.T) {
+			var pick DraftPick
+			if err := json.Unmarshal([]byte(tc.data), &pick); err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if pick.IsKeeper.Bool() != tc.expected {
+				t.Errorf("expected is_keeper %v, got %v", tc.expected, pick.IsKeeper)
+			}
+		})
+	}
+}
+
+func TestKeeper_Report(t *testing.T) {
+	league := &League{
+		LeagueID: "league",
+		Season:   "2025",
+		Status:   "complete",
+		Settings: &Settings{MaxKeepers: 1, DraftRounds: 15},
+		Metadata: &LeagueMetadata{KeeperDeadline: "2026-08-01"},
+	}
+	rosters := []*Roster{
+		{RosterID: 2, Players: []string{"A", "B"}, Keepers: []string{"A", "B"}},
+		{RosterID: 1, Players: []string{"C", "D", "E"}, Keepers: []string{"C"}},
+	}
+	seasons := []*KeeperSeason{
+		{Season: "2025", Picks: []*DraftPick{
+			{PlayerID: "A", Round: 5},
+			{PlayerID: "C", Round: 3, IsKeeper: true},
+			{PlayerID: "E", Round: 9, IsKeeper: true},
+		}},
+		{Season: "2024", Picks: []*DraftPick{
+			{PlayerID: "C", Round: 4, IsKeeper: true},
+		}},
+		{Season: "2023", Picks: []*DraftPick{
+			{PlayerID: "C", Round: 5},
+		}},
+	}
+
+	tt := []struct {
+		testcase     string
+		rules        KeeperRules
+		rosterID     int
+		playerID     string
+		expectedCost int
+		eligible     bool
+		shouldPass   bool
+	}{
+		{
+			"drafted player costs round drafted",
+			KeeperRules{},
+			2,
+			"A",
+			5,
+			true,
+			true,
+		},
+		{
+			"round penalty",
+			KeeperRules{RoundPenalty: 1},
+			2,
+			"A",
+			4,
+			true,
+			true,
+		},
+		{
+			"undrafted player costs last round",
+			KeeperRules{},
+			2,
+			"B",
+			15,
+			true,
+			true,
+		},
+		{
+			"escalation per year kept",
+			// 5th rounder kept twice: 5 - 1 - 2, from the round of the last non-keeper pick.
+			KeeperRules{RoundPenalty: 1, EscalationPerYear: 1},
+			1,
+			"C",
+			2,
+			true,
+			true,
+		},
+		{
+			"max years kept",
+			KeeperRules{MaxYearsKept: 2},
+			1,
+			"C",
+			5,
+			false,
+			true,
+		},
+		{
+			"kept before the history starts",
+			// 9th round keeper with no earlier pick: 9 + 1 was the base, so 10 - 1 - 1.
+			KeeperRules{RoundPenalty: 1, EscalationPerYear: 1},
+			1,
+			"E",
+			8,
+			true,
+			true,
+		},
+		{
+			"invalid rules",
+			KeeperRules{MaxKeepers: -1},
+			0,
+			"",
+			0,
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testcase, func(t *testing.T) {
+			report, err := NewKeeperReport(league, rosters, seasons, tc.rules)
+			if err != nil {
+				if tc.shouldPass {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+				t.Logf("expected error: %v", err)
+				return
+			}
+
+			if !tc.shouldPass {
+				t.Errorf("expected failure but got report: %v", report)
+				return
+			}
+
+			if report.Season != "2026" || report.Deadline != "2026-08-01" {
+				t.Errorf("unexpected report season %q or deadline %q", report.Season, report.Deadline)
+				return
+			}
+
+			var candidate *KeeperCandidate
+			for _, c := range report.Roster(tc.rosterID).Candidates {
+				if c.PlayerID == tc.playerID {
+					candidate = c
+				}
+			}
+			if candidate == nil {
+				t.Errorf("expected candidate %s on roster %d", tc.playerID, tc.rosterID)
+				return
+			}
+
+			if candidate.CostRound != tc.expectedCost || candidate.Eligible != tc.eligible {
+				t.Errorf("unexpected candidate: %+v", candidate)
+				return
+			}
+		})
+	}
+
+	report, err := NewKeeperReport(league, rosters, seasons, KeeperRules{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !report.Roster(2).OverLimit || report.Roster(1).OverLimit {
+		t.Errorf("expected only roster 2 to be over the keeper limit")
+	}
+	if c := report.Roster(1).Candidates; c[0].PlayerID != "D" || c[0].YearsKept != 0 {
+		t.Errorf("expected undrafted player D to be the cheapest keeper, got %+v", c[0])
+	}
+}
@@ -0,0 +1,222 @@
+package sleeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+)
+
+const (
+	defaultSeasonConcurrency = 4
+
+	// Sleeper's playoff_round_type values; 0 plays every round in one week.
+	playoffRoundsTwoWeekChampionship = 1
+	playoffRoundsTwoWeeks            = 2
+)
+
+// LeagueSeason holds one season of a league: its rosters, weekly matchups and winners bracket.
+type LeagueSeason struct {
+	League         *League            `json:"league"`
+	Rosters        []*Roster          `json:"rosters"`
+	Matchups       map[int][]*Matchup `json:"matchups"` // by week
+	WinnersBracket []*PlayoffMatchup  `json:"winners_bracket"`
+}
+
+// Owner returns the user ID owning a roster this season, or "" for orphaned or unknown rosters.
+func (s *LeagueSeason) Owner(rosterID int) string {
+	for _, r := range s.Rosters {
+		if r != nil && r.RosterID == rosterID {
+			return r.OwnerID
+		}
+	}
+	return ""
+}
+
+// PlayoffWeekStart returns the first week of the playoffs, or 0 if the league has no playoffs.
+func (s *LeagueSeason) PlayoffWeekStart() int {
+	if s.League == nil || s.League.Settings == nil {
+		return 0
+	}
+	return s.League.Settings.PlayoffWeekStart.Int()
+}
+
+// IsPlayoffWeek reports whether a week is in the playoffs.
+func (s *LeagueSeason) IsPlayoffWeek(week int) bool {
+	start := s.PlayoffWeekStart()
+	return start > 0 && week >= start
+}
+
+// PlayoffRoundWeeks returns the weeks a winners bracket round is played over. Depending on the league's
+// playoff settings, rounds, or only the championship round, may span two weeks.
+func (s *LeagueSeason) PlayoffRoundWeeks(round int) []int {
+	start := s.PlayoffWeekStart()
+	if start == 0 || round < 1 {
+		return nil
+	}
+
+	var roundType int
+	if s.League.Settings != nil {
+		roundType = s.League.Settings.PlayoffRoundType.Int()
+	}
+	switch roundType {
+	case playoffRoundsTwoWeeks:
+		week := start + (round-1)*2
+		return []int{week, week + 1}
+	case playoffRoundsTwoWeekChampionship:
+		week := start + round - 1
+		if round == playoffRounds(s.WinnersBracket) {
+			return []int{week, week + 1}
+		}
+		return []int{week}
+	default:
+		return []int{start + round - 1}
+	}
+}
+
+// RosterPoints returns a roster's points over the given weeks.
+func (s *LeagueSeason) RosterPoints(rosterID int, weeks ...int) float64 {
+	var points float64
+	for _, week := range weeks {
+		for _, m := range s.Matchups[week] {
+			if m != nil && m.RosterID == rosterID {
+				points += matchupPoints(m)
+			}
+		}
+	}
+	return points
+}
+
+// Games returns the games of a week as pairs of matchups sharing a matchup ID.
+func (s *LeagueSeason) Games(week int) [][2]*Matchup {
+	byID := make(map[int][]*Matchup)
+	var ids []int
+	for _, m := range s.Matchups[week] {
+		if m == nil || m.MatchupID == 0 {
+			continue
+		}
+		if _, ok := byID[m.MatchupID]; !ok {
+			ids = append(ids, m.MatchupID)
+		}
+		byID[m.MatchupID] = append(byID[m.MatchupID], m)
+	}
+
+	var games [][2]*Matchup
+	for _, id := range ids {
+		if teams := byID[id]; len(teams) == 2 {
+			games = append(games, [2]*Matchup{teams[0], teams[1]})
+		}
+	}
+	return games
+}
+
+// Weeks returns the weeks with matchups, in order.
+func (s *LeagueSeason) Weeks() []int {
+	return slices.Sorted(maps.Keys(s.Matchups))
+}
+
+// RegularSeasonWeeks returns the weeks with matchups before the playoffs, in order.
+func (s *LeagueSeason) RegularSeasonWeeks() []int {
+	return slices.DeleteFunc(s.Weeks(), s.IsPlayoffWeek)
+}
+
+// GetLeagueSeasons retrieves every season of a league by following the PreviousLeagueID chain, along with
+// each season's rosters, weekly matchups and winners bracket. Seasons are returned newest first.
+func (c *Client) GetLeagueSeasons(ctx context.Context, leagueID string) ([]*LeagueSeason, error) {
+	history, err := c.GetLeagueHistory(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("getting league seasons: %w", err)
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		slots    = make(chan struct{}, defaultSeasonConcurrency)
+		fetchErr []error
+	)
+	run := func(fn func() error) {
+		wg.Go(func() {
+			slots <- struct{}{}
+			defer func() { <-slots }()
+			if err := fn(); err != nil {
+				mu.Lock()
+				fetchErr = append(fetchErr, err)
+				mu.Unlock()
+			}
+		})
+	}
+
+	seasons := make([]*LeagueSeason, len(history))
+	for i, league := range history {
+		s := &LeagueSeason{League: league, Matchups: make(map[int][]*Matchup)}
+		seasons[i] = s
+
+		run(func() (err error) {
+			s.Rosters, err = c.GetLeagueRosters(ctx, league.LeagueID)
+			return err
+		})
+		if league.Status == leagueStatusPreDraft || league.Status == leagueStatusDrafting {
+			continue
+		}
+		run(func() (err error) {
+			s.WinnersBracket, err = c.GetLeagueWinnersBracket(ctx, league.LeagueID)
+			return err
+		})
+		for week := 1; week <= seasonWeeks(league); week++ {
+			run(func() error {
+				matchups, err := c.GetLeagueMatchups(ctx, league.LeagueID, week)
+				if err != nil {
+					return err
+				}
+				if len(matchups) == 0 {
+					return nil
+				}
+				mu.Lock()
+				s.Matchups[week] = matchups
+				mu.Unlock()
+				return nil
+			})
+		}
+	}
+	wg.Wait()
+
+	if len(fetchErr) > 0 {
+		return nil, fmt.Errorf("getting league seasons: %w", errors.Join(fetchErr...))
+	}
+	return seasons, nil
+}
+
+// seasonWeeks returns the number of weeks of matchups to fetch for a league: the last scored week when
+// known, otherwise the length of the sport's regular season.
+func seasonWeeks(l *League) int {
+	if l.Settings != nil {
+		if last := l.Settings.LastScoredLeg.Int(); last > 0 {
+			return last
+		}
+	}
+	if cal, ok := sportCalendars[sport(l.Sport)]; ok {
+		return cal.regularSeasonWeeks
+	}
+	return sportCalendars[SportNFL].regularSeasonWeeks
+}
+
+// playoffRounds returns the number of rounds in a bracket.
+func playoffRounds(bracket []*PlayoffMatchup) int {
+	var rounds int
+	for _, m := range bracket {
+		if m != nil {
+			rounds = max(rounds, m.Round)
+		}
+	}
+	return rounds
+}
+
+// matchupPoints returns a matchup's points, preferring commissioner-adjusted custom points.
+func matchupPoints(m *Matchup) float64 {
+	if m.CustomPoints != nil {
+		return *m.CustomPoints
+	}
+	return m.Points
+}
@@ -0,0 +1,82 @@
+package sleeper
+
+import (
+	"slices"
+	"testing"
+)
+
+func intPtr(i int) *int {
+	return &i
+}
+
+// testSeason builds a completed season of a league. Rosters are numbered from 1 in the order of their owners;
+// an empty owner leaves the roster orphaned.
+func testSeason(leagueID, season string, playoffWeekStart int, owners ...string) *LeagueSeason {
+	s := &LeagueSeason{
+		League: &League{
+			LeagueID: leagueID,
+			Season:   season,
+			Status:   leagueStatusComplete,
+			Settings: &Settings{PlayoffWeekStart: FlexInt(playoffWeekStart)},
+		},
+		Matchups: make(map[int][]*Matchup),
+	}
+	for i, owner := range owners {
+		s.Rosters = append(s.Rosters, &Roster{RosterID: i + 1, OwnerID: owner})
+	}
+	return s
+}
+
+func testMatchup(rosterID, matchupID int, points float64) *Matchup {
+	return &Matchup{RosterID: rosterID, MatchupID: matchupID, Points: points}
+}
+
+// testScoredMatchup returns a matchup whose points are the sum of its starters' points.
+func testScoredMatchup(rosterID, matchupID int, starters, bench map[string]float64) *Matchup {
+	m := testMatchup(rosterID, matchupID, 0)
+	m.PlayersPoints = make(map[string]float64, len(starters)+len(bench))
+	for playerID, points := range starters {
+		m.Starters = append(m.Starters, playerID)
+		m.PlayersPoints[playerID] = points
+		m.Points += points
+	}
+	for playerID, points := range bench {
+		m.PlayersPoints[playerID] = points
+	}
+	return m
+}
+
+func TestLeagueSeason_Weeks(t *testing.T) {
+	tt := []struct {
+		testcase             string
+		playoffWeekStart     int
+		playoffRoundType     int
+		expectedRegularWeeks []int
+		expectedRoundWeeks   [][]int // weeks of rounds 1 and 2
+	}{
+		{"one week rounds", 3, 0, []int{1, 2}, [][]int{{3}, {4}}},
+		{"two week championship", 3, playoffRoundsTwoWeekChampionship, []int{1, 2}, [][]int{{3}, {4, 5}}},
+		{"two week rounds", 2, playoffRoundsTwoWeeks, []int{1}, [][]int{{2, 3}, {4, 5}}},
+		{"no playoffs", 0, 0, []int{1, 2, 3, 4, 5}, [][]int{nil, nil}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testcase, func(t *testing.T) {
+			s := testSeason("l1", "2025", tc.playoffWeekStart, "u1", "u2")
+			s.League.Settings.PlayoffRoundType = FlexInt(tc.playoffRoundType)
+			for week := 1; week <= 5; week++ {
+				s.Matchups[week] = []*Matchup{testMatchup(1, 1, 100), testMatchup(2, 1, 90)}
+			}
+			s.WinnersBracket = []*PlayoffMatchup{{Round: 1, MatchID: 1}, {Round: 2, MatchID: 2}}
+
+			if weeks := s.RegularSeasonWeeks(); !slices.Equal(weeks, tc.expectedRegularWeeks) {
+				t.Errorf("expected regular season weeks %v, got %v", tc.expectedRegularWeeks, weeks)
+			}
+			for i, expected := range tc.expectedRoundWeeks {
+				if weeks := s.PlayoffRoundWeeks(i + 1); !slices.Equal(weeks, expected) {
+					t.Errorf("expected round %d weeks %v, got %v", i+1, expected, weeks)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,432 @@
+package sleeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	defaultCrawlMaxLeagues = 500
+	defaultCrawlMaxUsers   = 500
+)
+
+// LeaguemateGraph links users to the leagues they are members of.
+type LeaguemateGraph struct {
+	members map[string][]string // league ID -> user IDs
+	leagues map[string][]string // user ID -> league IDs
+}
+
+// NewLeaguemateGraph builds a graph from each league's member user IDs.
+func NewLeaguemateGraph(members map[string][]string) *LeaguemateGraph {
+	g := &LeaguemateGraph{
+		members: make(map[string][]string, len(members)),
+		leagues: make(map[string][]string),
+	}
+	for leagueID, userIDs := range members {
+		seen := make(map[string]bool, len(userIDs))
+		for _, userID := range userIDs {
+			if userID == "" || seen[userID] {
+				continue
+			}
+			seen[userID] = true
+			g.members[leagueID] = append(g.members[leagueID], userID)
+			g.leagues[userID] = append(g.leagues[userID], leagueID)
+		}
+	}
+	for _, leagueIDs := range g.leagues {
+		sort.Strings(leagueIDs)
+	}
+	return g
+}
+
+// Users returns the IDs of every user in the graph, sorted.
+func (g *LeaguemateGraph) Users() []string {
+	return slices.Sorted(maps.Keys(g.leagues))
+}
+
+// Leagues returns the IDs of the leagues a user is a member of, sorted.
+func (g *LeaguemateGraph) Leagues(userID string) []string {
+	return g.leagues[userID]
+}
+
+// SharedLeagues returns the IDs of the leagues both users are members of, sorted.
+func (g *LeaguemateGraph) SharedLeagues(userA, userB string) []string {
+	var shared []string
+	for _, leagueID := range g.leagues[userA] {
+		if slices.Contains(g.members[leagueID], userB) {
+			shared = append(shared, leagueID)
+		}
+	}
+	return shared
+}
+
+// LeaguemateCount is how many leagues a user shares with another user.
+type LeaguemateCount struct {
+	UserID  string   `json:"user_id"`
+	Leagues []string `json:"leagues"` // IDs of the shared leagues
+}
+
+// Leaguemates returns the users sharing at least one league with a user, most shared leagues first.
+func (g *LeaguemateGraph) Leaguemates(userID string) []*LeaguemateCount {
+	byUserID := make(map[string]*LeaguemateCount)
+	var counts []*LeaguemateCount
+	for _, leagueID := range g.leagues[userID] {
+		for _, mateID := range g.members[leagueID] {
+			if mateID == userID {
+				continue
+			}
+			c := byUserID[mateID]
+			if c == nil {
+				c = &LeaguemateCount{UserID: mateID}
+				byUserID[mateID] = c
+				counts = append(counts, c)
+			}
+			c.Leagues = append(c.Leagues, leagueID)
+		}
+	}
+
+	sort.Slice(counts, func(i, j int) bool {
+		if len(counts[i].Leagues) != len(counts[j].Leagues) {
+			return len(counts[i].Leagues) > len(counts[j].Leagues)
+		}
+		return counts[i].UserID < counts[j].UserID
+	})
+	return counts
+}
+
+// Leaguemate is a user sharing leagues with another user.
+type Leaguemate struct {
+	User    *User              `json:"user"`
+	Leagues []*PortfolioLeague `json:"leagues"` // the shared leagues, in portfolio order
+}
+
+// GetLeaguemates retrieves a user's leagues for the requested sports and seasons and the members of each,
+// and returns the users they share leagues with, most shared leagues first.
+func (c *Client) GetLeaguemates(ctx context.Context, usernameOrID string, options PortfolioOptions) ([]*Leaguemate, error) {
+	options.SkipRosters = true
+	portfolio, err := c.GetUserPortfolio(ctx, usernameOrID, options)
+	if err != nil {
+		return nil, fmt.Errorf("getting leaguemates: %w", err)
+	}
+	if options.Concurrency == 0 {
+		options.Concurrency = defaultPortfolioConcurrency
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		slots    = make(chan struct{}, options.Concurrency)
+		members  = make(map[string][]string, len(portfolio.Leagues))
+		users    = make(map[string]*User)
+		fetchErr []error
+	)
+	for _, pl := range portfolio.Leagues {
+		wg.Go(func() {
+			slots <- struct{}{}
+			defer func() { <-slots }()
+
+			leagueUsers, err := c.GetLeagueUsers(ctx, pl.League.LeagueID)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				fetchErr = append(fetchErr, fmt.Errorf("league %s: %w", pl.League.LeagueID, err))
+				return
+			}
+			for _, u := range leagueUsers {
+				if u == nil {
+					continue
+				}
+				members[pl.League.LeagueID] = append(members[pl.League.LeagueID], u.UserID)
+				users[u.UserID] = u
+			}
+		})
+	}
+	wg.Wait()
+
+	if len(fetchErr) > 0 {
+		return nil, fmt.Errorf("getting leaguemates: %w", errors.Join(fetchErr...))
+	}
+
+	var mates []*Leaguemate
+	for _, count := range NewLeaguemateGraph(members).Leaguemates(portfolio.User.UserID) {
+		mate := &Leaguemate{User: users[count.UserID]}
+		for _, pl := range portfolio.Leagues {
+			if slices.Contains(count.Leagues, pl.League.LeagueID) {
+				mate.Leagues = append(mate.Leagues, pl)
+			}
+		}
+		mates = append(mates, mate)
+	}
+	return mates, nil
+}
+
+// GetSharedLeagues returns the leagues two users are both in for the requested sports and seasons.
+func (c *Client) GetSharedLeagues(ctx context.Context, userA, userB string, options PortfolioOptions) ([]*PortfolioLeague, error) {
+	var errs []string
+	if strings.TrimSpace(userA) == "" {
+		errs = append(errs, "userA is required")
+	}
+	if strings.TrimSpace(userB) == "" {
+		errs = append(errs, "userB is required")
+	}
+	if len(errs) > 0 {
+		return nil, errors.New(strings.Join(errs, "\n"))
+	}
+
+	options.SkipRosters = true
+	var (
+		wg         sync.WaitGroup
+		a, b       *UserPortfolio
+		errA, errB error
+	)
+	wg.Go(func() {
+		a, errA = c.GetUserPortfolio(ctx, userA, options)
+	})
+	wg.Go(func() {
+		b, errB = c.GetUserPortfolio(ctx, userB, options)
+	})
+	wg.Wait()
+
+	if err := errors.Join(errA, errB); err != nil {
+		return nil, fmt.Errorf("getting shared leagues: %w", err)
+	}
+
+	inB := make(map[string]bool, len(b.Leagues))
+	for _, pl := range b.Leagues {
+		inB[pl.League.LeagueID] = true
+	}
+	var shared []*PortfolioLeague
+	for _, pl := range a.Leagues {
+		if inB[pl.League.LeagueID] {
+			shared = append(shared, pl)
+		}
+	}
+	return shared, nil
+}
+
+// CrawlerOptions holds options for a LeagueCrawler.
+type CrawlerOptions struct {
+	Sport             sport         // Sport to crawl (default: NFL)
+	Seasons           []string      // Seasons to crawl each user's leagues for (required)
+	MaxLeagues        int           // Stop discovering leagues after this many (default: 500)
+	MaxUsers          int           // Stop discovering users after this many, including the seed (default: 500)
+	RequestsPerSecond float64       // Limit the crawl below the client's rate limit (default: the client's rate limit)
+	OnLeague          func(*League) // Called for each newly discovered league
+}
+
+func (o *CrawlerOptions) validate() error {
+	var errs []string
+	if len(o.Seasons) == 0 {
+		errs = append(errs, "Seasons is required")
+	}
+	if o.MaxLeagues < 0 {
+		errs = append(errs, "MaxLeagues must be greater than or equal to zero")
+	}
+	if o.MaxUsers < 0 {
+		errs = append(errs, "MaxUsers must be greater than or equal to zero")
+	}
+	if o.RequestsPerSecond < 0 {
+		errs = append(errs, "RequestsPerSecond must be greater than or equal to zero")
+	}
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+// CrawlState is the progress of a LeagueCrawler. It can be marshaled to JSON and passed to
+// ResumeLeagueCrawler to continue an interrupted crawl.
+type CrawlState struct {
+	SeedUserID     string              `json:"seed_user_id"`
+	PendingUsers   []string            `json:"pending_users"`   // users whose leagues have not been fetched
+	PendingLeagues []string            `json:"pending_leagues"` // leagues whose members have not been fetched
+	Users          map[string]bool     `json:"users"`           // every user discovered
+	Leagues        []string            `json:"leagues"`         // every league discovered, in discovery order
+	Members        map[string][]string `json:"members"`         // member user IDs of each league fetched
+}
+
+// Graph returns the leaguemate graph of the leagues whose members have been fetched.
+func (s *CrawlState) Graph() *LeaguemateGraph {
+	return NewLeaguemateGraph(s.Members)
+}
+
+// LeagueCrawler discovers leagues reachable from a seed user breadth first: each user's leagues are
+// fetched, then each league's members, then those members' leagues, until the queue is exhausted or a
+// limit is reached. Requests are made one at a time.
+type LeagueCrawler struct {
+	client  *Client
+	options CrawlerOptions
+	limiter *rate.Limiter
+	state   *CrawlState
+	leagues map[string]bool
+}
+
+// NewLeagueCrawler creates a crawler starting from a seed user ID. Use GetUser to resolve a username.
+func NewLeagueCrawler(client *Client, seedUserID string, options CrawlerOptions) (*LeagueCrawler, error) {
+	seedUserID = strings.TrimSpace(seedUserID)
+	if seedUserID == "" {
+		return nil, errors.New("seedUserID is required")
+	}
+
+	return ResumeLeagueCrawler(client, &CrawlState{
+		SeedUserID:   seedUserID,
+		PendingUsers: []string{seedUserID},
+		Users:        map[string]bool{seedUserID: true},
+	}, options)
+}
+
+// ResumeLeagueCrawler creates a crawler that continues from a previously saved state.
+func ResumeLeagueCrawler(client *Client, state *CrawlState, options CrawlerOptions) (*LeagueCrawler, error) {
+	var errs []string
+	if client == nil {
+		errs = append(errs, "client is required")
+	}
+	if state == nil {
+		errs = append(errs, "state is required")
+	}
+	if err := options.validate(); err != nil {
+		errs = append(errs, fmt.Sprintf("invalid options: %v", err))
+	}
+	if len(errs) > 0 {
+		return nil, errors.New(strings.Join(errs, "\n"))
+	}
+
+	if options.Sport == "" {
+		options.Sport = SportNFL
+	}
+	if options.MaxLeagues == 0 {
+		options.MaxLeagues = defaultCrawlMaxLeagues
+	}
+	if options.MaxUsers == 0 {
+		options.MaxUsers = defaultCrawlMaxUsers
+	}
+
+	cr := &LeagueCrawler{
+		client:  client,
+		options: options,
+		state:   state,
+		leagues: make(map[string]bool, len(state.Leagues)),
+	}
+	if options.RequestsPerSecond > 0 {
+		cr.limiter = rate.NewLimiter(rate.Limit(options.RequestsPerSecond), 1)
+	}
+	if cr.state.Users == nil {
+		cr.state.Users = make(map[string]bool)
+	}
+	if cr.state.Members == nil {
+		cr.state.Members = make(map[string][]string)
+	}
+	for _, leagueID := range state.Leagues {
+		cr.leagues[leagueID] = true
+	}
+	return cr, nil
+}
+
+// Run crawls until there is nothing left to fetch or a limit is reached. If the context is cancelled or a
+// request fails, Run returns the error and the item being fetched stays queued, so calling Run again (or
+// resuming from State) retries it.
+func (cr *LeagueCrawler) Run(ctx context.Context) error {
+	for !cr.Done() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if len(cr.state.PendingLeagues) > 0 {
+			if err := cr.crawlLeague(ctx, cr.state.PendingLeagues[0]); err != nil {
+				return err
+			}
+			cr.state.PendingLeagues = cr.state.PendingLeagues[1:]
+			continue
+		}
+
+		if err := cr.crawlUser(ctx, cr.state.PendingUsers[0]); err != nil {
+			return err
+		}
+		cr.state.PendingUsers = cr.state.PendingUsers[1:]
+	}
+	return nil
+}
+
+// Done reports whether the crawl is finished. The members of every discovered league are fetched before
+// the crawl finishes, even once MaxLeagues is reached.
+func (cr *LeagueCrawler) Done() bool {
+	if len(cr.state.PendingLeagues) > 0 {
+		return false
+	}
+	return len(cr.state.PendingUsers) == 0 || len(cr.state.Leagues) >= cr.options.MaxLeagues
+}
+
+// State returns the crawl's progress. It must not be modified while Run is active.
+func (cr *LeagueCrawler) State() *CrawlState {
+	return cr.state
+}
+
+// Leagues returns the IDs of the leagues discovered so far, in discovery order.
+func (cr *LeagueCrawler) Leagues() []string {
+	return cr.state.Leagues
+}
+
+func (cr *LeagueCrawler) crawlUser(ctx context.Context, userID string) error {
+	for _, season := range cr.options.Seasons {
+		if err := cr.wait(ctx); err != nil {
+			return err
+		}
+		leagues, err := cr.client.GetUserLeagues(ctx, userID, cr.options.Sport, season)
+		if err != nil {
+			return fmt.Errorf("crawling user %s: %w", userID, err)
+		}
+
+		for _, l := range leagues {
+			if l == nil || cr.leagues[l.LeagueID] || len(cr.state.Leagues) >= cr.options.MaxLeagues {
+				continue
+			}
+			cr.leagues[l.LeagueID] = true
+			cr.state.Leagues = append(cr.state.Leagues, l.LeagueID)
+			cr.state.PendingLeagues = append(cr.state.PendingLeagues, l.LeagueID)
+			if cr.options.OnLeague != nil {
+				cr.options.OnLeague(l)
+			}
+		}
+	}
+	return nil
+}
+
+func (cr *LeagueCrawler) crawlLeague(ctx context.Context, leagueID string) error {
+	if err := cr.wait(ctx); err != nil {
+		return err
+	}
+	users, err := cr.client.GetLeagueUsers(ctx, leagueID)
+	if err != nil {
+		return fmt.Errorf("crawling league %s: %w", leagueID, err)
+	}
+
+	var members []string
+	for _, u := range users {
+		if u == nil || u.UserID == "" {
+			continue
+		}
+		members = append(members, u.UserID)
+		if cr.state.Users[u.UserID] || len(cr.state.Users) >= cr.options.MaxUsers {
+			continue
+		}
+		cr.state.Users[u.UserID] = true
+		cr.state.PendingUsers = append(cr.state.PendingUsers, u.UserID)
+	}
+	cr.state.Members[leagueID] = members
+	return nil
+}
+
+func (cr *LeagueCrawler) wait(ctx context.Context) error {
+	if cr.limiter == nil {
+		return nil
+	}
+	return cr.limiter.Wait(ctx)
+}
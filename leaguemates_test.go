@@ -0,0 +1,199 @@
+package sleeper
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+)
+
+func TestLeaguemateGraph(t *testing.T) {
+	g := NewLeaguemateGraph(map[string][]string{
+		"l1": {"u1", "u2", "u3"},
+		"l2": {"u1", "u2"},
+		"l3": {"u2", "u4", "u4"},
+	})
+
+	if users := g.Users(); len(users) != 4 {
+		t.Errorf("expected 4 users, got %v", users)
+	}
+	if shared := g.SharedLeagues("u1", "u2"); len(shared) != 2 || shared[0] != "l1" || shared[1] != "l2" {
+		t.Errorf("expected shared leagues [l1 l2], got %v", shared)
+	}
+	if shared := g.SharedLeagues("u1", "u4"); len(shared) != 0 {
+		t.Errorf("expected no shared leagues, got %v", shared)
+	}
+
+	mates := g.Leaguemates("u2")
+	if len(mates) != 3 {
+		t.Fatalf("expected 3 leaguemates, got %d", len(mates))
+	}
+	if mates[0].UserID != "u1" || len(mates[0].Leagues) != 2 {
+		t.Errorf("expected u1 with 2 shared leagues first, got %+v", mates[0])
+	}
+	if mates[2].UserID != "u4" || len(mates[2].Leagues) != 1 {
+		t.Errorf("expected u4 with 1 shared league last, got %+v", mates[2])
+	}
+}
+
+var leaguemateResponses = map[string]string{
+	"/user/alice":               `{"user_id": "u1", "username": "alice"}`,
+	"/user/bob":                 `{"user_id": "u2", "username": "bob"}`,
+	"/user/u1/leagues/nfl/2025": `[{"league_id": "l1", "season": "2025"}, {"league_id": "l2", "season": "2025"}]`,
+	"/user/u2/leagues/nfl/2025": `[{"league_id": "l1", "season": "2025"}, {"league_id": "l2", "season": "2025"}, {"league_id": "l3", "season": "2025"}]`,
+	"/user/u3/leagues/nfl/2025": `[{"league_id": "l1", "season": "2025"}, {"league_id": "l4", "season": "2025"}]`,
+	"/user/u4/leagues/nfl/2025": `[{"league_id": "l3", "season": "2025"}]`,
+	"/league/l1/users":          `[{"user_id": "u1", "username": "alice"}, {"user_id": "u2", "username": "bob"}, {"user_id": "u3", "username": "carol"}]`,
+	"/league/l2/users":          `[{"user_id": "u1", "username": "alice"}, {"user_id": "u2", "username": "bob"}]`,
+	"/league/l3/users":          `[{"user_id": "u2", "username": "bob"}, {"user_id": "u4", "username": "dave"}]`,
+	"/league/l4/users":          `[{"user_id": "u3", "username": "carol"}]`,
+}
+
+func TestGetLeaguemates(t *testing.T) {
+	c, _ := newAPIClient(leaguemateResponses)
+	options := PortfolioOptions{FromSeason: 2025, ToSeason: 2025}
+
+	mates, err := c.GetLeaguemates(context.Background(), "alice", options)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(mates) != 2 {
+		t.Fatalf("expected 2 leaguemates, got %d", len(mates))
+	}
+	if mates[0].User.Username != "bob" || len(mates[0].Leagues) != 2 {
+		t.Errorf("expected bob with 2 shared leagues first, got %s with %d", mates[0].User.Username, len(mates[0].Leagues))
+	}
+	if mates[1].User.Username != "carol" || len(mates[1].Leagues) != 1 {
+		t.Errorf("expected carol with 1 shared league, got %s with %d", mates[1].User.Username, len(mates[1].Leagues))
+	}
+
+	shared, err := c.GetSharedLeagues(context.Background(), "alice", "bob", options)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(shared) != 2 {
+		t.Errorf("expected 2 shared leagues, got %d", len(shared))
+	}
+
+	if _, err := c.GetSharedLeagues(context.Background(), "alice", " ", options); err == nil {
+		t.Errorf("expected failure for a missing user")
+	}
+}
+
+func TestLeagueCrawler(t *testing.T) {
+	tt := []struct {
+		testcase        string
+		options         CrawlerOptions
+		expectedLeagues []string
+		expectedUsers   int
+		shouldPass      bool
+	}{
+		{testcase: "full crawl", options: CrawlerOptions{Seasons: []string{"2025"}}, expectedLeagues: []string{"l1", "l2", "l3", "l4"}, expectedUsers: 4, shouldPass: true},
+		{testcase: "league limit", options: CrawlerOptions{Seasons: []string{"2025"}, MaxLeagues: 2}, expectedLeagues: []string{"l1", "l2"}, expectedUsers: 3, shouldPass: true},
+		{testcase: "user limit", options: CrawlerOptions{Seasons: []string{"2025"}, MaxUsers: 2}, expectedLeagues: []string{"l1", "l2", "l3"}, expectedUsers: 2, shouldPass: true},
+		{testcase: "rate limited", options: CrawlerOptions{Seasons: []string{"2025"}, RequestsPerSecond: 1000}, expectedLeagues: []string{"l1", "l2", "l3", "l4"}, expectedUsers: 4, shouldPass: true},
+		{testcase: "missing seasons", options: CrawlerOptions{}, shouldPass: false},
+		{testcase: "negative limit", options: CrawlerOptions{Seasons: []string{"2025"}, MaxLeagues: -1}, shouldPass: false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testcase, func(t *testing.T) {
+			c, _ := newAPIClient(leaguemateResponses)
+			var discovered []string
+			tc.options.OnLeague = func(l *League) {
+				discovered = append(discovered, l.LeagueID)
+			}
+
+			cr, err := NewLeagueCrawler(c, "u1", tc.options)
+			if err != nil {
+				if tc.shouldPass {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				t.Logf("expected error: %v", err)
+				return
+			}
+			if !tc.shouldPass {
+				t.Fatalf("expected failure but got %+v", cr)
+			}
+
+			if err := cr.Run(context.Background()); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !cr.Done() {
+				t.Errorf("expected crawl to be done")
+			}
+
+			leagues := cr.Leagues()
+			if len(leagues) != len(tc.expectedLeagues) || len(discovered) != len(tc.expectedLeagues) {
+				t.Fatalf("expected leagues %v, got %v (callback %v)", tc.expectedLeagues, leagues, discovered)
+			}
+			for i := range leagues {
+				if leagues[i] != tc.expectedLeagues[i] {
+					t.Errorf("expected leagues %v, got %v", tc.expectedLeagues, leagues)
+					break
+				}
+			}
+			if len(cr.State().Users) != tc.expectedUsers {
+				t.Errorf("expected %d users, got %d", tc.expectedUsers, len(cr.State().Users))
+			}
+		})
+	}
+}
+
+func TestLeagueCrawler_Resume(t *testing.T) {
+	responses := make(map[string]string, len(leaguemateResponses))
+	for path, body := range leaguemateResponses {
+		if path != "/league/l2/users" {
+			responses[path] = body
+		}
+	}
+	c, transport := newAPIClient(responses)
+	options := CrawlerOptions{Seasons: []string{"2025"}}
+
+	cr, err := NewLeagueCrawler(c, "u1", options)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := cr.Run(context.Background()); err == nil {
+		t.Fatalf("expected failure fetching league l2")
+	}
+	if cr.Done() || cr.State().PendingLeagues[0] != "l2" {
+		t.Fatalf("expected l2 to stay queued, got %v", cr.State().PendingLeagues)
+	}
+
+	by, err := json.Marshal(cr.State())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var state CrawlState
+	if err := json.Unmarshal(by, &state); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	transport.mu.Lock()
+	transport.responses[testAPIBaseURL+"/league/l2/users"] = []byte(leaguemateResponses["/league/l2/users"])
+	transport.mu.Unlock()
+
+	resumed, err := ResumeLeagueCrawler(c, &state, options)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := resumed.Run(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resumed.Leagues()) != 4 {
+		t.Errorf("expected 4 leagues, got %v", resumed.Leagues())
+	}
+	if transport.requests[testAPIBaseURL+"/user/u1/leagues/nfl/2025"] != 1 {
+		t.Errorf("expected the seed's leagues to be fetched once, got %d", transport.requests[testAPIBaseURL+"/user/u1/leagues/nfl/2025"])
+	}
+	if shared := resumed.State().Graph().SharedLeagues("u1", "u2"); len(shared) != 2 {
+		t.Errorf("expected 2 shared leagues in the graph, got %v", shared)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	cr, _ = NewLeagueCrawler(c, "u1", options)
+	if err := cr.Run(ctx); err == nil {
+		t.Errorf("expected failure for a cancelled context")
+	}
+}
@@ -5,15 +5,23 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 )
 
+// League statuses reported in League.Status.
+const (
+	leagueStatusPreDraft = "pre_draft"
+	leagueStatusDrafting = "drafting"
+	leagueStatusComplete = "complete"
+)
+
 // League represents a Sleeper fantasy league.
 type League struct {
 	Avatar                  string           `json:"avatar,omitempty"`
 	Sport                   string           `json:"sport,omitempty"`
 	LastMessageID           string           `json:"last_message_id,omitempty"`
-	LastMessageTime         int64            `json:"last_message_time,omitempty"`
+	LastMessageTime         Timestamp        `json:"last_message_time,omitzero"`
 	Shard                   int              `json:"shard,omitempty"`
 	LastTransactionID       int64            `json:"last_transaction_id,omitempty"`
 	LastPinnedMessageID     string           `json:"last_pinned_message_id,omitempty"`
@@ -43,59 +51,63 @@ type League struct {
 	Settings                *Settings        `json:"settings,omitempty"`
 	SeasonType              string           `json:"season_type,omitempty"`
 	LastMessageTextMap      string           `json:"last_message_text_map,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // ScoringSettings holds the scoring rules for a league.
 type ScoringSettings struct {
-	Sack         float64 `json:"sack,omitempty"`
-	FGM4049      float64 `json:"fgm_40_49,omitempty"`
-	FGMYds       float64 `json:"fgm_yds,omitempty"`
-	PassInt      float64 `json:"pass_int,omitempty"`
-	PtsAllow0    float64 `json:"pts_allow_0,omitempty"`
-	Pass2pt      float64 `json:"pass_2pt,omitempty"`
-	StTd         float64 `json:"st_td,omitempty"`
-	FGMYdsOver30 float64 `json:"fgm_yds_over_30,omitempty"`
-	RecTd        float64 `json:"rec_td,omitempty"`
-	FGM3039      float64 `json:"fgm_30_39,omitempty"`
-	FGM5059      float64 `json:"fgm_50_59,omitempty"`
-	XPMiss       float64 `json:"xpmiss,omitempty"`
-	RushTd       float64 `json:"rush_td,omitempty"`
-	DefPrTd      float64 `json:"def_pr_td,omitempty"`
-	Def4AndStop  float64 `json:"def_4_and_stop,omitempty"`
-	Rec2pt       float64 `json:"rec_2pt,omitempty"`
-	PassIntTd    float64 `json:"pass_int_td,omitempty"`
-	StFumRec     float64 `json:"st_fum_rec,omitempty"`
-	FGMiss       float64 `json:"fgmiss,omitempty"`
-	FF           float64 `json:"ff,omitempty"`
-	Rec          float64 `json:"rec,omitempty"`
-	PtsAllow1420 float64 `json:"pts_allow_14_20,omitempty"`
-	FGM019       float64 `json:"fgm_0_19,omitempty"`
-	DefKrTd      float64 `json:"def_kr_td,omitempty"`
-	Int          float64 `json:"int,omitempty"`
-	DefStFumRec  float64 `json:"def_st_fum_rec,omitempty"`
-	FumLost      float64 `json:"fum_lost,omitempty"`
-	PtsAllow16   float64 `json:"pts_allow_1_6,omitempty"`
-	FGM2029      float64 `json:"fgm_20_29,omitempty"`
-	PtsAllow2127 float64 `json:"pts_allow_21_27,omitempty"`
-	XPM          float64 `json:"xpm,omitempty"`
-	Rush2pt      float64 `json:"rush_2pt,omitempty"`
-	FumRec       float64 `json:"fum_rec,omitempty"`
-	DefStTd      float64 `json:"def_st_td,omitempty"`
-	FGM50p       float64 `json:"fgm_50p,omitempty"`
-	DefTd        float64 `json:"def_td,omitempty"`
-	Safe         float64 `json:"safe,omitempty"`
-	PassYd       float64 `json:"pass_yd,omitempty"`
-	BlkKick      float64 `json:"blk_kick,omitempty"`
-	PassTd       float64 `json:"pass_td,omitempty"`
-	RushYd       float64 `json:"rush_yd,omitempty"`
-	Fum          float64 `json:"fum,omitempty"`
-	PtsAllow2834 float64 `json:"pts_allow_28_34,omitempty"`
-	PtsAllow35p  float64 `json:"pts_allow_35p,omitempty"`
-	FumRecTd     float64 `json:"fum_rec_td,omitempty"`
-	RecYd        float64 `json:"rec_yd,omitempty"`
-	DefStFF      float64 `json:"def_st_ff,omitempty"`
-	PtsAllow713  float64 `json:"pts_allow_7_13,omitempty"`
-	StFF         float64 `json:"st_ff,omitempty"`
+	Sack         FlexFloat `json:"sack,omitempty"`
+	FGM4049      FlexFloat `json:"fgm_40_49,omitempty"`
+	FGMYds       FlexFloat `json:"fgm_yds,omitempty"`
+	PassInt      FlexFloat `json:"pass_int,omitempty"`
+	PtsAllow0    FlexFloat `json:"pts_allow_0,omitempty"`
+	Pass2pt      FlexFloat `json:"pass_2pt,omitempty"`
+	StTd         FlexFloat `json:"st_td,omitempty"`
+	FGMYdsOver30 FlexFloat `json:"fgm_yds_over_30,omitempty"`
+	RecTd        FlexFloat `json:"rec_td,omitempty"`
+	FGM3039      FlexFloat `json:"fgm_30_39,omitempty"`
+	FGM5059      FlexFloat `json:"fgm_50_59,omitempty"`
+	XPMiss       FlexFloat `json:"xpmiss,omitempty"`
+	RushTd       FlexFloat `json:"rush_td,omitempty"`
+	DefPrTd      FlexFloat `json:"def_pr_td,omitempty"`
+	Def4AndStop  FlexFloat `json:"def_4_and_stop,omitempty"`
+	Rec2pt       FlexFloat `json:"rec_2pt,omitempty"`
+	PassIntTd    FlexFloat `json:"pass_int_td,omitempty"`
+	StFumRec     FlexFloat `json:"st_fum_rec,omitempty"`
+	FGMiss       FlexFloat `json:"fgmiss,omitempty"`
+	FF           FlexFloat `json:"ff,omitempty"`
+	Rec          FlexFloat `json:"rec,omitempty"`
+	PtsAllow1420 FlexFloat `json:"pts_allow_14_20,omitempty"`
+	FGM019       FlexFloat `json:"fgm_0_19,omitempty"`
+	DefKrTd      FlexFloat `json:"def_kr_td,omitempty"`
+	Int          FlexFloat `json:"int,omitempty"`
+	DefStFumRec  FlexFloat `json:"def_st_fum_rec,omitempty"`
+	FumLost      FlexFloat `json:"fum_lost,omitempty"`
+	PtsAllow16   FlexFloat `json:"pts_allow_1_6,omitempty"`
+	FGM2029      FlexFloat `json:"fgm_20_29,omitempty"`
+	PtsAllow2127 FlexFloat `json:"pts_allow_21_27,omitempty"`
+	XPM          FlexFloat `json:"xpm,omitempty"`
+	Rush2pt      FlexFloat `json:"rush_2pt,omitempty"`
+	FumRec       FlexFloat `json:"fum_rec,omitempty"`
+	DefStTd      FlexFloat `json:"def_st_td,omitempty"`
+	FGM50p       FlexFloat `json:"fgm_50p,omitempty"`
+	DefTd        FlexFloat `json:"def_td,omitempty"`
+	Safe         FlexFloat `json:"safe,omitempty"`
+	PassYd       FlexFloat `json:"pass_yd,omitempty"`
+	BlkKick      FlexFloat `json:"blk_kick,omitempty"`
+	PassTd       FlexFloat `json:"pass_td,omitempty"`
+	RushYd       FlexFloat `json:"rush_yd,omitempty"`
+	Fum          FlexFloat `json:"fum,omitempty"`
+	PtsAllow2834 FlexFloat `json:"pts_allow_28_34,omitempty"`
+	PtsAllow35p  FlexFloat `json:"pts_allow_35p,omitempty"`
+	FumRecTd     FlexFloat `json:"fum_rec_td,omitempty"`
+	RecYd        FlexFloat `json:"rec_yd,omitempty"`
+	DefStFF      FlexFloat `json:"def_st_ff,omitempty"`
+	PtsAllow713  FlexFloat `json:"pts_allow_7_13,omitempty"`
+	StFF         FlexFloat `json:"st_ff,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // LeagueMetadata contains additional information about a league.
@@ -103,61 +115,77 @@ type LeagueMetadata struct {
 	AutoContinue               string `json:"auto_continue,omitempty"`
 	KeeperDeadline             string `json:"keeper_deadline,omitempty"`
 	LatestLeagueWinnerRosterID string `json:"latest_league_winner_roster_id,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// WinnerRosterID returns the roster ID of the league's most recent champion, or 0 if there is none yet.
+func (m *LeagueMetadata) WinnerRosterID() int {
+	if m == nil {
+		return 0
+	}
+	id, err := strconv.Atoi(strings.TrimSpace(m.LatestLeagueWinnerRosterID))
+	if err != nil {
+		return 0
+	}
+	return id
 }
 
 // Settings contains configuration options for a league.
 type Settings struct {
-	BestBall                 int `json:"best_ball,omitempty"`
-	LastReport               int `json:"last_report,omitempty"`
-	WaiverBudget             int `json:"waiver_budget,omitempty"`
-	DisableAdds              int `json:"disable_adds,omitempty"`
-	CapacityOverride         int `json:"capacity_override,omitempty"`
-	TaxiDeadline             int `json:"taxi_deadline,omitempty"`
-	DraftRounds              int `json:"draft_rounds,omitempty"`
-	ReserveAllowNA           int `json:"reserve_allow_na,omitempty"`
-	StartWeek                int `json:"start_week,omitempty"`
-	PlayoffSeedType          int `json:"playoff_seed_type,omitempty"`
-	PlayoffTeams             int `json:"playoff_teams,omitempty"`
-	VetoVotesNeeded          int `json:"veto_votes_needed,omitempty"`
-	Squads                   int `json:"squads,omitempty"`
-	NumTeams                 int `json:"num_teams,omitempty"`
-	DailyWaiversHour         int `json:"daily_waivers_hour,omitempty"`
-	PlayoffType              int `json:"playoff_type,omitempty"`
-	TaxiSlots                int `json:"taxi_slots,omitempty"`
-	SubStartTimeEligibility  int `json:"sub_start_time_eligibility,omitempty"`
-	LastScoredLeg            int `json:"last_scored_leg,omitempty"`
-	DailyWaiversDays         int `json:"daily_waivers_days,omitempty"`
-	SubLockIfStarterActive   int `json:"sub_lock_if_starter_active,omitempty"`
-	PlayoffWeekStart         int `json:"playoff_week_start,omitempty"`
-	WaiverClearDays          int `json:"waiver_clear_days,omitempty"`
-	ReserveAllowDoubtful     int `json:"reserve_allow_doubtful,omitempty"`
-	CommissionerDirectInvite int `json:"commissioner_direct_invite,omitempty"`
-	VetoAutoPoll             int `json:"veto_auto_poll,omitempty"`
-	ReserveAllowDNR          int `json:"reserve_allow_dnr,omitempty"`
-	TaxiAllowVets            int `json:"taxi_allow_vets,omitempty"`
-	WaiverDayOfWeek          int `json:"waiver_day_of_week,omitempty"`
-	PlayoffRoundType         int `json:"playoff_round_type,omitempty"`
-	ReserveAllowOut          int `json:"reserve_allow_out,omitempty"`
-	ReserveAllowSus          int `json:"reserve_allow_sus,omitempty"`
-	VetoShowVotes            int `json:"veto_show_votes,omitempty"`
-	TradeDeadline            int `json:"trade_deadline,omitempty"`
-	TaxiYears                int `json:"taxi_years,omitempty"`
-	DailyWaivers             int `json:"daily_waivers,omitempty"`
-	FaabSuggestions          int `json:"faab_suggestions,omitempty"`
-	DisableTrades            int `json:"disable_trades,omitempty"`
-	PickTrading              int `json:"pick_trading,omitempty"`
-	Type                     int `json:"type,omitempty"`
-	MaxKeepers               int `json:"max_keepers,omitempty"`
-	WaiverType               int `json:"waiver_type,omitempty"`
-	MaxSubs                  int `json:"max_subs,omitempty"`
-	LeagueAverageMatch       int `json:"league_average_match,omitempty"`
-	TradeReviewDays          int `json:"trade_review_days,omitempty"`
-	BenchLock                int `json:"bench_lock,omitempty"`
-	OffseasonAdds            int `json:"offseason_adds,omitempty"`
-	Leg                      int `json:"leg,omitempty"`
-	ReserveSlots             int `json:"reserve_slots,omitempty"`
-	ReserveAllowCov          int `json:"reserve_allow_cov,omitempty"`
-	DailyWaiversLastRan      int `json:"daily_waivers_last_ran,omitempty"`
+	BestBall                 FlexInt `json:"best_ball,omitempty"`
+	LastReport               FlexInt `json:"last_report,omitempty"`
+	WaiverBudget             FlexInt `json:"waiver_budget,omitempty"`
+	DisableAdds              FlexInt `json:"disable_adds,omitempty"`
+	CapacityOverride         FlexInt `json:"capacity_override,omitempty"`
+	TaxiDeadline             FlexInt `json:"taxi_deadline,omitempty"`
+	DraftRounds              FlexInt `json:"draft_rounds,omitempty"`
+	ReserveAllowNA           FlexInt `json:"reserve_allow_na,omitempty"`
+	StartWeek                FlexInt `json:"start_week,omitempty"`
+	PlayoffSeedType          FlexInt `json:"playoff_seed_type,omitempty"`
+	PlayoffTeams             FlexInt `json:"playoff_teams,omitempty"`
+	VetoVotesNeeded          FlexInt `json:"veto_votes_needed,omitempty"`
+	Squads                   FlexInt `json:"squads,omitempty"`
+	NumTeams                 FlexInt `json:"num_teams,omitempty"`
+	DailyWaiversHour         FlexInt `json:"daily_waivers_hour,omitempty"`
+	PlayoffType              FlexInt `json:"playoff_type,omitempty"`
+	TaxiSlots                FlexInt `json:"taxi_slots,omitempty"`
+	SubStartTimeEligibility  FlexInt `json:"sub_start_time_eligibility,omitempty"`
+	LastScoredLeg            FlexInt `json:"last_scored_leg,omitempty"`
+	DailyWaiversDays         FlexInt `json:"daily_waivers_days,omitempty"`
+	SubLockIfStarterActive   FlexInt `json:"sub_lock_if_starter_active,omitempty"`
+	PlayoffWeekStart         FlexInt `json:"playoff_week_start,omitempty"`
+	WaiverClearDays          FlexInt `json:"waiver_clear_days,omitempty"`
+	ReserveAllowDoubtful     FlexInt `json:"reserve_allow_doubtful,omitempty"`
+	CommissionerDirectInvite FlexInt `json:"commissioner_direct_invite,omitempty"`
+	VetoAutoPoll             FlexInt `json:"veto_auto_poll,omitempty"`
+	ReserveAllowDNR          FlexInt `json:"reserve_allow_dnr,omitempty"`
+	TaxiAllowVets            FlexInt `json:"taxi_allow_vets,omitempty"`
+	WaiverDayOfWeek          FlexInt `json:"waiver_day_of_week,omitempty"`
+	PlayoffRoundType         FlexInt `json:"playoff_round_type,omitempty"`
+	ReserveAllowOut          FlexInt `json:"reserve_allow_out,omitempty"`
+	ReserveAllowSus          FlexInt `json:"reserve_allow_sus,omitempty"`
+	VetoShowVotes            FlexInt `json:"veto_show_votes,omitempty"`
+	TradeDeadline            FlexInt `json:"trade_deadline,omitempty"`
+	TaxiYears                FlexInt `json:"taxi_years,omitempty"`
+	DailyWaivers             FlexInt `json:"daily_waivers,omitempty"`
+	FaabSuggestions          FlexInt `json:"faab_suggestions,omitempty"`
+	DisableTrades            FlexInt `json:"disable_trades,omitempty"`
+	PickTrading              FlexInt `json:"pick_trading,omitempty"`
+	Type                     FlexInt `json:"type,omitempty"`
+	MaxKeepers               FlexInt `json:"max_keepers,omitempty"`
+	WaiverType               FlexInt `json:"waiver_type,omitempty"`
+	MaxSubs                  FlexInt `json:"max_subs,omitempty"`
+	LeagueAverageMatch       FlexInt `json:"league_average_match,omitempty"`
+	TradeReviewDays          FlexInt `json:"trade_review_days,omitempty"`
+	BenchLock                FlexInt `json:"bench_lock,omitempty"`
+	OffseasonAdds            FlexInt `json:"offseason_adds,omitempty"`
+	Leg                      FlexInt `json:"leg,omitempty"`
+	ReserveSlots             FlexInt `json:"reserve_slots,omitempty"`
+	ReserveAllowCov          FlexInt `json:"reserve_allow_cov,omitempty"`
+	DailyWaiversLastRan      FlexInt `json:"daily_waivers_last_ran,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // Matchup represents a weekly matchup in a league.
@@ -170,6 +198,8 @@ type Matchup struct {
 	Starters       []string           `json:"starters,omitempty"`
 	StartersPoints []float64          `json:"starters_points,omitempty"`
 	PlayersPoints  map[string]float64 `json:"players_points,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // PlayoffMatchup represents a playoff bracket matchup.
@@ -183,12 +213,16 @@ type PlayoffMatchup struct {
 	Team1From *PlayoffMatchupFrom `json:"t1_from,omitempty"` // Where t1 comes from, either winner or loser of the match id, necessary to show bracket progression.
 	Team2From *PlayoffMatchupFrom `json:"t2_from,omitempty"` // Where t2 comes from, either winner or loser of the match id, necessary to show bracket progression.
 	Placement *int                `json:"p,omitempty"`       // Position/placement (e.g., 1st place, 3rd place, 5th place).
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // PlayoffMatchupFrom describes where a playoff team comes from in the bracket.
 type PlayoffMatchupFrom struct {
 	WinnerOfMatch *int `json:"w,omitempty"` // Winner of match id
 	LoserOfMatch  *int `json:"l,omitempty"` // Loser of match id
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetLeague retrieves a League by league ID.
@@ -206,7 +240,7 @@ func (c *Client) GetLeague(ctx context.Context, leagueID string) (*League, error
 		return nil, fmt.Errorf("getting league: %w", err)
 	}
 
-	if err := json.Unmarshal(by, &league); err != nil {
+	if err := c.decode(endpointLeague, by, &league); err != nil {
 		return nil, fmt.Errorf("unmarshaling league: %w", err)
 	}
 
@@ -228,7 +262,7 @@ func (c *Client) GetLeagueRosters(ctx context.Context, leagueID string) ([]*Rost
 		return nil, fmt.Errorf("getting league rosters: %w", err)
 	}
 
-	if err := json.Unmarshal(by, &rosters); err != nil {
+	if err := c.decode(endpointLeagueRosters, by, &rosters); err != nil {
 		return nil, fmt.Errorf("unmarshaling league rosters: %w", err)
 	}
 
@@ -250,13 +284,36 @@ func (c *Client) GetLeagueUsers(ctx context.Context, leagueID string) ([]*User,
 		return nil, fmt.Errorf("getting league users: %w", err)
 	}
 
-	if err := json.Unmarshal(by, &users); err != nil {
+	if err := c.decode(endpointLeagueUsers, by, &users); err != nil {
 		return nil, fmt.Errorf("unmarshaling league users: %w", err)
 	}
 
 	return users, nil
 }
 
+// GetLeagueMembers retrieves all users for a given league ID with their team names, team avatars and
+// commissioner status.
+func (c *Client) GetLeagueMembers(ctx context.Context, leagueID string) ([]*LeagueMember, error) {
+	leagueID = strings.TrimSpace(leagueID)
+	if leagueID == "" {
+		return nil, errors.New("leagueID is required")
+	}
+
+	endpoint := c.buildEndpoint(endpointLeagueUsers, leagueID)
+
+	var members []*LeagueMember
+	by, err := c.getRequest(ctx, endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("getting league members: %w", err)
+	}
+
+	if err := c.decode(endpointLeagueUsers, by, &members); err != nil {
+		return nil, fmt.Errorf("unmarshaling league members: %w", err)
+	}
+
+	return members, nil
+}
+
 // GetLeagueMatchups retrieves all matchups for a given league ID and week.
 func (c *Client) GetLeagueMatchups(ctx context.Context, leagueID string, week int) ([]*Matchup, error) {
 
@@ -280,7 +337,7 @@ func (c *Client) GetLeagueMatchups(ctx context.Context, leagueID string, week in
 		return nil, fmt.Errorf("getting league matchups: %w", err)
 	}
 
-	if err := json.Unmarshal(by, &matchups); err != nil {
+	if err := c.decode(endpointLeagueMatchups, by, &matchups); err != nil {
 		return nil, fmt.Errorf("unmarshaling league matchups: %w", err)
 	}
 
@@ -309,7 +366,7 @@ func (c *Client) GetTransactions(ctx context.Context, leagueID string, week int)
 		return nil, fmt.Errorf("getting league transactions: %w", err)
 	}
 
-	if err := json.Unmarshal(by, &transactions); err != nil {
+	if err := c.decode(endpointLeagueTransactions, by, &transactions); err != nil {
 		return nil, fmt.Errorf("unmarshaling league transactions: %w", err)
 	}
 
@@ -331,7 +388,7 @@ func (c *Client) GetLeagueTradedPicks(ctx context.Context, leagueID string) ([]*
 		return nil, fmt.Errorf("getting traded picks: %w", err)
 	}
 
-	if err := json.Unmarshal(data, &picks); err != nil {
+	if err := c.decode(endpointLeagueTradedPicks, data, &picks); err != nil {
 		return nil, fmt.Errorf("unmarshaling traded picks: %w", err)
 	}
 
@@ -353,7 +410,7 @@ func (c *Client) GetLeagueWinnersBracket(ctx context.Context, leagueID string) (
 		return nil, fmt.Errorf("getting winners bracket: %w", err)
 	}
 
-	if err := json.Unmarshal(data, &matchups); err != nil {
+	if err := c.decode(endpointLeagueWinnersBracket, data, &matchups); err != nil {
 		return nil, fmt.Errorf("unmarshaling winners bracket: %w", err)
 	}
 
@@ -375,9 +432,41 @@ func (c *Client) GetLeagueLosersBracket(ctx context.Context, leagueID string) ([
 		return nil, fmt.Errorf("getting losers bracket: %w", err)
 	}
 
-	if err := json.Unmarshal(data, &matchups); err != nil {
+	if err := c.decode(endpointLeagueLosersBracket, data, &matchups); err != nil {
 		return nil, fmt.Errorf("unmarshaling losers bracket: %w", err)
 	}
 
 	return matchups, nil
 }
+
+// GetLeagueHistory retrieves a league and every previous season of it by following the PreviousLeagueID chain.
+// Leagues are returned newest first, starting with the league for the given league ID.
+func (c *Client) GetLeagueHistory(ctx context.Context, leagueID string) ([]*League, error) {
+	leagueID = strings.TrimSpace(leagueID)
+	if leagueID == "" {
+		return nil, errors.New("leagueID is required")
+	}
+
+	var history []*League
+	seen := make(map[string]bool)
+	for leagueID != "" && leagueID != "0" && !seen[leagueID] {
+		seen[leagueID] = true
+
+		league, err := c.GetLeague(ctx, leagueID)
+		if err != nil {
+			return nil, fmt.Errorf("getting league history: %w", err)
+		}
+		if league == nil {
+			break
+		}
+
+		history = append(history, league)
+		leagueID = league.PreviousLeagueID
+	}
+
+	if len(history) == 0 {
+		return nil, errors.New("league not found")
+	}
+
+	return history, nil
+}
@@ -167,6 +167,54 @@ func TestLeague_GetUsers(t *testing.T) {
 	}
 }
 
+func TestLeague_GetMembers(t *testing.T) {
+	tt := []struct {
+		testcase         string
+		leagueID         string
+		expectedMemberCt int
+		shouldPass       bool
+	}{
+		{
+			"valid league ID",
+			"289646328504385536",
+			14,
+			true,
+		},
+		{
+			"missing league ID",
+			"",
+			0,
+			false,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testcase, func(t *testing.T) {
+			members, err := testClient.GetLeagueMembers(context.Background(), tc.leagueID)
+			if err != nil {
+				if tc.shouldPass {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+				t.Logf("expected error: %v", err)
+				return
+			}
+
+			if !tc.shouldPass {
+				t.Errorf("expected failure but got members: %v", members)
+				return
+			}
+
+			if len(members) != tc.expectedMemberCt {
+				t.Errorf("expected %d members, got %d", tc.expectedMemberCt, len(members))
+				return
+			}
+
+			t.Logf("retrieved %d members", len(members))
+		})
+	}
+}
+
 func TestLeague_GetMatchups(t *testing.T) {
 	tt := []struct {
 		testcase          string
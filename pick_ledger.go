@@ -0,0 +1,215 @@
+package sleeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	defaultLedgerSeasons = 3 // Sleeper allows trading picks up to three seasons ahead
+)
+
+// PickLedgerOptions holds options for building a PickLedger.
+type PickLedgerOptions struct {
+	Seasons int // Number of upcoming seasons to enumerate (default: 3)
+	Weeks   int // Number of transaction weeks scanned per season when building ownership chains (default: 18)
+}
+
+// PickTransfer records a single change of ownership of a draft pick.
+type PickTransfer struct {
+	TransactionID string    `json:"transaction_id"`
+	Season        string    `json:"season"` // season in which the trade happened
+	Week          int       `json:"week"`
+	Created       Timestamp `json:"created"`
+	FromRosterID  int       `json:"from_roster_id"`
+	ToRosterID    int       `json:"to_roster_id"`
+}
+
+// LedgerPick is a single future draft pick and its current owner.
+type LedgerPick struct {
+	Season           string          `json:"season"`
+	Round            int             `json:"round"`
+	OriginalRosterID int             `json:"original_roster_id"`
+	OwnerRosterID    int             `json:"owner_roster_id"`
+	Chain            []*PickTransfer `json:"chain,omitempty"` // chronological chain of ownership
+}
+
+// Traded reports whether the pick is currently owned by a roster other than its original owner.
+func (p *LedgerPick) Traded() bool {
+	return p.OwnerRosterID != p.OriginalRosterID
+}
+
+// PickLedger enumerates every future draft pick for a league's upcoming seasons.
+type PickLedger struct {
+	LeagueID string        `json:"league_id"`
+	Seasons  []string      `json:"seasons"`
+	Rounds   int           `json:"rounds"`
+	Picks    []*LedgerPick `json:"picks"` // sorted by season, round and original roster
+}
+
+// NewPickLedger builds a PickLedger from a league, its traded picks (see GetLeagueTradedPicks) and,
+// optionally, its trades (see GetTradeHistory) which are used to build each pick's chain of ownership.
+func NewPickLedger(league *League, traded []*TradedDraftPick, trades []*Trade, options PickLedgerOptions) (*PickLedger, error) {
+	if league == nil {
+		return nil, errors.New("league is required")
+	}
+	if league.Settings == nil || league.Settings.DraftRounds < 1 {
+		return nil, errors.New("league has no draft rounds configured")
+	}
+	if league.TotalRosters < 1 {
+		return nil, errors.New("league has no rosters configured")
+	}
+	if options.Seasons < 0 {
+		return nil, errors.New("Seasons must be greater than or equal to zero")
+	}
+	if options.Seasons == 0 {
+		options.Seasons = defaultLedgerSeasons
+	}
+
+	season, err := strconv.Atoi(league.Season)
+	if err != nil {
+		return nil, fmt.Errorf("parsing league season %q: %w", league.Season, err)
+	}
+
+	// Once the league has drafted, the current season's picks are spent.
+	first := season + 1
+	if league.Status == leagueStatusPreDraft || league.Status == leagueStatusDrafting {
+		first = season
+	}
+
+	ledger := &PickLedger{
+		LeagueID: league.LeagueID,
+		Rounds:   league.Settings.DraftRounds.Int(),
+	}
+
+	index := make(map[string]*LedgerPick)
+	for s := first; s < first+options.Seasons; s++ {
+		seasonStr := strconv.Itoa(s)
+		ledger.Seasons = append(ledger.Seasons, seasonStr)
+		for round := 1; round <= ledger.Rounds; round++ {
+			for rosterID := 1; rosterID <= league.TotalRosters; rosterID++ {
+				pick := &LedgerPick{
+					Season:           seasonStr,
+					Round:            round,
+					OriginalRosterID: rosterID,
+					OwnerRosterID:    rosterID,
+				}
+				ledger.Picks = append(ledger.Picks, pick)
+				index[PickAsset(seasonStr, round, rosterID).Key()] = pick
+			}
+		}
+	}
+
+	for _, trade := range NewTradeHistory(trades, nil).Trades {
+		for _, m := range trade.Moves {
+			if m.Asset.Kind != TradeAssetPick {
+				continue
+			}
+			pick, ok := index[m.Asset.Key()]
+			if !ok {
+				continue
+			}
+			pick.Chain = append(pick.Chain, &PickTransfer{
+				TransactionID: trade.TransactionID,
+				Season:        trade.Season,
+				Week:          trade.Week,
+				Created:       trade.Created,
+				FromRosterID:  m.FromRosterID,
+				ToRosterID:    m.ToRosterID,
+			})
+			pick.OwnerRosterID = m.ToRosterID
+		}
+	}
+
+	// Traded picks are the authoritative record of current ownership.
+	for _, t := range traded {
+		if t == nil {
+			continue
+		}
+		if pick, ok := index[PickAsset(t.Season, t.Round, t.RosterID).Key()]; ok {
+			pick.OwnerRosterID = t.OwnerID
+		}
+	}
+
+	return ledger, nil
+}
+
+// Pick returns the pick for a season and round that originally belonged to the given roster, or nil.
+func (l *PickLedger) Pick(season string, round, originalRosterID int) *LedgerPick {
+	for _, p := range l.Picks {
+		if p.Season == season && p.Round == round && p.OriginalRosterID == originalRosterID {
+			return p
+		}
+	}
+	return nil
+}
+
+// Round returns every pick for a season and round, e.g. "who owns each 2027 1st?".
+func (l *PickLedger) Round(season string, round int) []*LedgerPick {
+	var picks []*LedgerPick
+	for _, p := range l.Picks {
+		if p.Season == season && p.Round == round {
+			picks = append(picks, p)
+		}
+	}
+	return picks
+}
+
+// OwnedBy returns every pick currently owned by the given roster.
+func (l *PickLedger) OwnedBy(rosterID int) []*LedgerPick {
+	var picks []*LedgerPick
+	for _, p := range l.Picks {
+		if p.OwnerRosterID == rosterID {
+			picks = append(picks, p)
+		}
+	}
+	return picks
+}
+
+// Traded returns every pick that has changed hands.
+func (l *PickLedger) Traded() []*LedgerPick {
+	var picks []*LedgerPick
+	for _, p := range l.Picks {
+		if p.Traded() || len(p.Chain) > 0 {
+			picks = append(picks, p)
+		}
+	}
+	return picks
+}
+
+// GetPickLedger builds the future draft pick ownership ledger for a league, combining the league's
+// draft settings, its traded picks and the trades across every season of the league. Only transactions
+// are fetched for the trades; unlike GetTradeHistory, no drafts are downloaded.
+func (c *Client) GetPickLedger(ctx context.Context, leagueID string, options PickLedgerOptions) (*PickLedger, error) {
+	leagueID = strings.TrimSpace(leagueID)
+	if leagueID == "" {
+		return nil, errors.New("leagueID is required")
+	}
+	if options.Weeks < 0 {
+		return nil, errors.New("Weeks must be greater than or equal to zero")
+	}
+	if options.Weeks == 0 {
+		options.Weeks = defaultTradeWeeks
+	}
+
+	// The history starts with the league itself.
+	leagues, err := c.GetLeagueHistory(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("getting pick ledger: %w", err)
+	}
+
+	traded, err := c.GetLeagueTradedPicks(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("getting pick ledger: %w", err)
+	}
+
+	trades, err := c.getHistoryTrades(ctx, leagues, options.Weeks, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting pick ledger: %w", err)
+	}
+
+	return NewPickLedger(leagues[0], traded, trades, options)
+}
@@ -0,0 +1,160 @@
+package sleeper
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPickLedger_New(t *testing.T) {
+	league := &League{
+		LeagueID:     "league",
+		Season:       "2025",
+		Status:       "in_season",
+		TotalRosters: 4,
+		Settings:     &Settings{DraftRounds: 2},
+	}
+
+	trades := []*Trade{
+		{
+			TransactionID: "t1",
+			Season:        "2025",
+			Week:          2,
+			Created:       NewTimestamp(time.UnixMilli(100)),
+			Moves: []*TradeMove{
+				{Asset: PickAsset("2026", 1, 2), FromRosterID: 2, ToRosterID: 1},
+			},
+		},
+		{
+			TransactionID: "t2",
+			Season:        "2025",
+			Week:          6,
+			Created:       NewTimestamp(time.UnixMilli(200)),
+			Moves: []*TradeMove{
+				{Asset: PickAsset("2026", 1, 2), FromRosterID: 1, ToRosterID: 3},
+			},
+		},
+	}
+	traded := []*TradedDraftPick{
+		{Season: "2026", Round: 1, RosterID: 2, PreviousOwnerID: 1, OwnerID: 3},
+		{Season: "2027", Round: 2, RosterID: 4, PreviousOwnerID: 4, OwnerID: 1},
+	}
+
+	tt := []struct {
+		testcase      string
+		league        *League
+		options       PickLedgerOptions
+		expectedPicks int
+		shouldPass    bool
+	}{
+		{
+			"default seasons",
+			league,
+			PickLedgerOptions{},
+			3 * 2 * 4,
+			true,
+		},
+		{
+			"pre-draft league includes current season",
+			&League{Season: "2025", Status: "pre_draft", TotalRosters: 4, Settings: &Settings{DraftRounds: 2}},
+			PickLedgerOptions{Seasons: 1},
+			2 * 4,
+			true,
+		},
+		{
+			"missing draft rounds",
+			&League{Season: "2025", TotalRosters: 4},
+			PickLedgerOptions{},
+			0,
+			false,
+		},
+		{
+			"invalid seasons",
+			league,
+			PickLedgerOptions{Seasons: -1},
+			0,
+			false,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testcase, func(t *testing.T) {
+			ledger, err := NewPickLedger(tc.league, traded, trades, tc.options)
+			if err != nil {
+				if tc.shouldPass {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+				t.Logf("expected error: %v", err)
+				return
+			}
+
+			if !tc.shouldPass {
+				t.Errorf("expected failure but got ledger: %v", ledger)
+				return
+			}
+
+			if len(ledger.Picks) != tc.expectedPicks {
+				t.Errorf("expected %d picks, got %d", tc.expectedPicks, len(ledger.Picks))
+				return
+			}
+		})
+	}
+
+	ledger, err := NewPickLedger(league, traded, trades, PickLedgerOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if ledger.Seasons[0] != "2026" {
+		t.Errorf("expected first season 2026, got %s", ledger.Seasons[0])
+	}
+
+	pick := ledger.Pick("2026", 1, 2)
+	if pick == nil || pick.OwnerRosterID != 3 || len(pick.Chain) != 2 {
+		t.Fatalf("unexpected 2026 1st for roster 2: %+v", pick)
+	}
+
+	// Roster 1 owns its own six picks plus roster 4's 2027 2nd.
+	if got := len(ledger.OwnedBy(1)); got != 7 {
+		t.Errorf("expected roster 1 to own 7 picks, got %d", got)
+	}
+
+	if got := len(ledger.Traded()); got != 2 {
+		t.Errorf("expected 2 traded picks, got %d", got)
+	}
+
+	if got := len(ledger.Round("2027", 1)); got != 4 {
+		t.Errorf("expected 4 picks in the 2027 1st round, got %d", got)
+	}
+}
+
+func TestGetPickLedger(t *testing.T) {
+	c, transport := newAPIClient(map[string]string{
+		"/league/l2":                `{"league_id": "l2", "season": "2025", "status": "in_season", "total_rosters": 2, "previous_league_id": "l1", "draft_id": "d2", "settings": {"draft_rounds": 1}}`,
+		"/league/l1":                `{"league_id": "l1", "season": "2024", "status": "complete", "total_rosters": 2, "draft_id": "d1", "settings": {"draft_rounds": 1}}`,
+		"/league/l2/traded_picks":   `[{"season": "2026", "round": 1, "roster_id": 2, "previous_owner_id": 2, "owner_id": 1}]`,
+		"/league/l2/transactions/1": `[]`,
+		"/league/l1/transactions/1": `[{"type": "trade", "transaction_id": "t1", "status": "complete", "leg": 1, "roster_ids": [1, 2], "draft_picks": [{"season": "2026", "round": 1, "roster_id": 2, "previous_owner_id": 2, "owner_id": 1}]}]`,
+	})
+
+	ledger, err := c.GetPickLedger(context.Background(), "l2", PickLedgerOptions{Seasons: 1, Weeks: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	pick := ledger.Pick("2026", 1, 2)
+	if pick == nil || pick.OwnerRosterID != 1 || len(pick.Chain) != 1 || pick.Chain[0].TransactionID != "t1" {
+		t.Errorf("expected roster 2's 2026 1st owned by roster 1 through t1, got %+v", pick)
+	}
+	for url := range transport.requests {
+		if strings.Contains(url, "/draft/") {
+			t.Errorf("expected no draft requests, got %s", url)
+		}
+	}
+
+	if _, err := c.GetPickLedger(context.Background(), "l2", PickLedgerOptions{Weeks: -1}); err == nil {
+		t.Errorf("expected failure for negative weeks")
+	}
+}
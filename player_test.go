@@ -2,7 +2,9 @@ package sleeper
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
+	"time"
 )
 
 func TestPlayer_List(t *testing.T) {
@@ -135,3 +137,32 @@ func TestPlayer_ListTrending(t *testing.T) {
 		})
 	}
 }
+
+func TestPlayer_Unmarshal(t *testing.T) {
+	data := `{"player_id": "4046", "number": "15", "age": 29, "years_exp": "8", "search_rank": "", "depth_chart_order": null, "birth_date": "1996-08-21", "espn_id": "3139477", "yahoo_id": 30123}`
+
+	var player Player
+	if err := json.Unmarshal([]byte(data), &player); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if player.Number == nil || *player.Number != 15 || player.Age == nil || *player.Age != 29 {
+		t.Errorf("expected number 15 and age 29, got %v and %v", player.Number, player.Age)
+	}
+	if player.YearsExp != 8 || player.SearchRank != 0 || player.DepthChartOrder != nil {
+		t.Errorf("unexpected years exp %d, search rank %d or depth chart order %v", player.YearsExp, player.SearchRank, player.DepthChartOrder)
+	}
+	if expected := time.Date(1996, time.August, 21, 0, 0, 0, 0, time.UTC); !player.BirthDate.Time().Equal(expected) {
+		t.Errorf("expected birth date %v, got %v", expected, player.BirthDate)
+	}
+	if player.ESPNID == nil || *player.ESPNID != 3139477 || player.YahooID == nil || *player.YahooID != 30123 {
+		t.Errorf("unexpected third-party IDs: %v, %v", player.ESPNID, player.YahooID)
+	}
+
+	var metadata DraftPickMetadata
+	if err := json.Unmarshal([]byte(`{"number": "00"}`), &metadata); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if metadata.Number != "00" {
+		t.Errorf("expected jersey number 00, got %q", metadata.Number)
+	}
+}
@@ -27,55 +27,59 @@ type Player struct {
 	Position              string          `json:"position"`
 	Team                  *string         `json:"team"`
 	TeamAbbr              *string         `json:"team_abbr"`
-	Number                *int            `json:"number"`
-	Age                   *int            `json:"age"`
+	Number                *FlexInt        `json:"number"`
+	Age                   *FlexInt        `json:"age"`
 	Height                string          `json:"height"`
 	Weight                string          `json:"weight"`
 	College               string          `json:"college"`
 	HighSchool            *string         `json:"high_school"`
-	BirthDate             *string         `json:"birth_date"`
+	BirthDate             Timestamp       `json:"birth_date"`
 	BirthCity             *string         `json:"birth_city"`
 	BirthState            *string         `json:"birth_state"`
 	BirthCountry          *string         `json:"birth_country"`
-	YearsExp              int             `json:"years_exp"`
+	YearsExp              FlexInt         `json:"years_exp"`
 	Active                bool            `json:"active"`
-	SearchRank            int             `json:"search_rank"`
+	SearchRank            FlexInt         `json:"search_rank"`
 	SearchFirstName       string          `json:"search_first_name"`
 	SearchLastName        string          `json:"search_last_name"`
 	SearchFullName        string          `json:"search_full_name"`
 	FantasyPositions      []string        `json:"fantasy_positions"`
 	DepthChartPosition    *string         `json:"depth_chart_position"`
-	DepthChartOrder       *int            `json:"depth_chart_order"`
+	DepthChartOrder       *FlexInt        `json:"depth_chart_order"`
 	InjuryStatus          *string         `json:"injury_status"`
 	InjuryBodyPart        *string         `json:"injury_body_part"`
 	InjuryNotes           *string         `json:"injury_notes"`
-	InjuryStartDate       *string         `json:"injury_start_date"`
+	InjuryStartDate       Timestamp       `json:"injury_start_date"`
 	PracticeParticipation *string         `json:"practice_participation"`
 	PracticeDescription   *string         `json:"practice_description"`
-	NewsUpdated           *int64          `json:"news_updated"`
-	TeamChangedAt         *string         `json:"team_changed_at"`
+	NewsUpdated           Timestamp       `json:"news_updated"`
+	TeamChangedAt         Timestamp       `json:"team_changed_at"`
 	Hashtag               string          `json:"hashtag"`
 	Metadata              *PlayerMetadata `json:"metadata"`
 
 	// Third-party IDs
-	ESPNID        *int    `json:"espn_id"`
-	YahooID       *int    `json:"yahoo_id"`
-	RotowireID    *int    `json:"rotowire_id"`
-	RotoworldID   *int    `json:"rotoworld_id"`
-	GSIID         *string `json:"gsis_id"`
-	SportradarID  string  `json:"sportradar_id"`
-	StatsID       *int    `json:"stats_id"`
-	FantasyDataID *int    `json:"fantasy_data_id"`
-	SwishID       *int    `json:"swish_id"`
-	OptaID        *string `json:"opta_id"`
-	PandascoreID  *string `json:"pandascore_id"`
-	OddsjamID     *string `json:"oddsjam_id"`
-	KalshiID      *string `json:"kalshi_id"`
+	ESPNID        *FlexInt `json:"espn_id"`
+	YahooID       *FlexInt `json:"yahoo_id"`
+	RotowireID    *FlexInt `json:"rotowire_id"`
+	RotoworldID   *FlexInt `json:"rotoworld_id"`
+	GSIID         *string  `json:"gsis_id"`
+	SportradarID  string   `json:"sportradar_id"`
+	StatsID       *FlexInt `json:"stats_id"`
+	FantasyDataID *FlexInt `json:"fantasy_data_id"`
+	SwishID       *FlexInt `json:"swish_id"`
+	OptaID        *string  `json:"opta_id"`
+	PandascoreID  *string  `json:"pandascore_id"`
+	OddsjamID     *string  `json:"oddsjam_id"`
+	KalshiID      *string  `json:"kalshi_id"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 type PlayerMetadata struct {
 	ChannelID  string `json:"channel_id"`
 	RookieYear string `json:"rookie_year"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 type TrendingPlayerOptions struct {
@@ -98,7 +102,7 @@ func (c *Client) ListNFLPlayers(ctx context.Context) (map[string]Player, error)
 		return nil, fmt.Errorf("getting players: %w", err)
 	}
 
-	if err := json.Unmarshal(by, &players); err != nil {
+	if err := c.decode(endpointNFLPlayers, by, &players); err != nil {
 		return nil, fmt.Errorf("unmarshaling players: %w", err)
 	}
 
@@ -149,7 +153,7 @@ func (c *Client) ListTrendingPlayers(ctx context.Context, sport sport, trendingT
 		return nil, fmt.Errorf("getting trending players: %w", err)
 	}
 
-	if err := json.Unmarshal(by, &players); err != nil {
+	if err := c.decode(endpointTrendingPlayers, by, &players); err != nil {
 		return nil, fmt.Errorf("unmarshaling trending players: %w", err)
 	}
 
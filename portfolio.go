@@ -0,0 +1,217 @@
+package sleeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const defaultPortfolioConcurrency = 4
+
+// PortfolioOptions holds options for GetUserPortfolio.
+type PortfolioOptions struct {
+	Sports      []sport // Sports to include (default: NFL)
+	FromSeason  int     // First season to include (default: ToSeason)
+	ToSeason    int     // Last season to include (default: the current year)
+	SkipRosters bool    // Do not fetch each league's rosters to find the user's roster
+	Concurrency int     // Maximum concurrent requests (default: 4); the client's rate limit still applies
+}
+
+func (o *PortfolioOptions) validate() error {
+	var errs []string
+	if o.FromSeason < 0 {
+		errs = append(errs, "FromSeason must be greater than or equal to zero")
+	}
+	if o.ToSeason < 0 {
+		errs = append(errs, "ToSeason must be greater than or equal to zero")
+	}
+	if o.FromSeason > 0 && o.ToSeason > 0 && o.FromSeason > o.ToSeason {
+		errs = append(errs, "FromSeason must be less than or equal to ToSeason")
+	}
+	if o.Concurrency < 0 {
+		errs = append(errs, "Concurrency must be greater than or equal to zero")
+	}
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+// PortfolioLeague is one of a user's leagues, with the user's roster in it.
+type PortfolioLeague struct {
+	Sport  sport   `json:"sport"`
+	Season string  `json:"season"`
+	Status string  `json:"status"` // league status, e.g. "pre_draft", "drafting", "in_season", "complete"
+	League *League `json:"league"`
+	Roster *Roster `json:"roster,omitempty"` // nil if the user has no roster or rosters were skipped
+}
+
+// PortfolioGroup holds a user's leagues for one sport and season.
+type PortfolioGroup struct {
+	Sport   sport              `json:"sport"`
+	Season  string             `json:"season"`
+	Leagues []*PortfolioLeague `json:"leagues"`
+}
+
+// UserPortfolio holds every league a user is in across sports and seasons.
+type UserPortfolio struct {
+	User    *User              `json:"user"`
+	Leagues []*PortfolioLeague `json:"leagues"` // newest season first, then by sport and league name
+	Groups  []*PortfolioGroup  `json:"groups"`  // in the same order as Leagues
+}
+
+// Group returns the user's leagues for a sport and season.
+func (p *UserPortfolio) Group(sport sport, season string) []*PortfolioLeague {
+	for _, g := range p.Groups {
+		if g.Sport == sport && g.Season == season {
+			return g.Leagues
+		}
+	}
+	return nil
+}
+
+// FindUserRoster returns the roster a user owns or co-owns, or nil.
+func FindUserRoster(rosters []*Roster, userID string) *Roster {
+	for _, r := range rosters {
+		if r != nil && r.OwnerID == userID {
+			return r
+		}
+	}
+	for _, r := range rosters {
+		if r != nil && slices.Contains(r.CoOwners, userID) {
+			return r
+		}
+	}
+	return nil
+}
+
+// GetUserPortfolio resolves a username or user ID and retrieves the user's leagues for every requested sport
+// and season concurrently, along with the user's roster in each league.
+func (c *Client) GetUserPortfolio(ctx context.Context, usernameOrID string, options PortfolioOptions) (*UserPortfolio, error) {
+	var errs []string
+	usernameOrID = strings.TrimSpace(usernameOrID)
+	if usernameOrID == "" {
+		errs = append(errs, "usernameOrID is required")
+	}
+	if err := options.validate(); err != nil {
+		errs = append(errs, fmt.Sprintf("invalid options: %v", err))
+	}
+	if len(errs) > 0 {
+		return nil, errors.New(strings.Join(errs, "\n"))
+	}
+
+	if len(options.Sports) == 0 {
+		options.Sports = []sport{SportNFL}
+	}
+	if options.ToSeason == 0 {
+		options.ToSeason = time.Now().Year()
+	}
+	if options.FromSeason == 0 {
+		options.FromSeason = options.ToSeason
+	}
+	if options.Concurrency == 0 {
+		options.Concurrency = defaultPortfolioConcurrency
+	}
+
+	user, err := c.GetUser(ctx, usernameOrID)
+	if err != nil {
+		return nil, fmt.Errorf("getting user portfolio: %w", err)
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		slots    = make(chan struct{}, options.Concurrency)
+		leagues  = make(map[string]*PortfolioLeague)
+		fetchErr []error
+	)
+	run := func(fn func() error) {
+		wg.Go(func() {
+			slots <- struct{}{}
+			defer func() { <-slots }()
+			if err := fn(); err != nil {
+				mu.Lock()
+				fetchErr = append(fetchErr, err)
+				mu.Unlock()
+			}
+		})
+	}
+
+	for _, s := range options.Sports {
+		for season := options.FromSeason; season <= options.ToSeason; season++ {
+			run(func() error {
+				found, err := c.GetUserLeagues(ctx, user.UserID, s, strconv.Itoa(season))
+				if err != nil {
+					return fmt.Errorf("%s %d: %w", s, season, err)
+				}
+
+				mu.Lock()
+				defer mu.Unlock()
+				for _, l := range found {
+					if l == nil || leagues[l.LeagueID] != nil {
+						continue
+					}
+					leagues[l.LeagueID] = &PortfolioLeague{Sport: s, Season: l.Season, Status: l.Status, League: l}
+				}
+				return nil
+			})
+		}
+	}
+	wg.Wait()
+
+	if !options.SkipRosters && len(fetchErr) == 0 {
+		for _, pl := range leagues {
+			run(func() error {
+				rosters, err := c.GetLeagueRosters(ctx, pl.League.LeagueID)
+				if err != nil {
+					return fmt.Errorf("league %s: %w", pl.League.LeagueID, err)
+				}
+				pl.Roster = FindUserRoster(rosters, user.UserID)
+				return nil
+			})
+		}
+		wg.Wait()
+	}
+
+	if len(fetchErr) > 0 {
+		return nil, fmt.Errorf("getting user portfolio: %w", errors.Join(fetchErr...))
+	}
+
+	return newUserPortfolio(user, leagues), nil
+}
+
+// newUserPortfolio sorts a user's leagues and groups them by sport and season.
+func newUserPortfolio(user *User, leagues map[string]*PortfolioLeague) *UserPortfolio {
+	p := &UserPortfolio{User: user}
+	for _, pl := range leagues {
+		p.Leagues = append(p.Leagues, pl)
+	}
+	sort.Slice(p.Leagues, func(i, j int) bool {
+		a, b := p.Leagues[i], p.Leagues[j]
+		if a.Season != b.Season {
+			return a.Season > b.Season
+		}
+		if a.Sport != b.Sport {
+			return a.Sport < b.Sport
+		}
+		if a.League.Name != b.League.Name {
+			return a.League.Name < b.League.Name
+		}
+		return a.League.LeagueID < b.League.LeagueID
+	})
+
+	for _, pl := range p.Leagues {
+		if n := len(p.Groups); n == 0 || p.Groups[n-1].Sport != pl.Sport || p.Groups[n-1].Season != pl.Season {
+			p.Groups = append(p.Groups, &PortfolioGroup{Sport: pl.Sport, Season: pl.Season})
+		}
+		g := p.Groups[len(p.Groups)-1]
+		g.Leagues = append(g.Leagues, pl)
+	}
+	return p
+}
@@ -0,0 +1,139 @@
+package sleeper
+
+import (
+	"context"
+	"testing"
+)
+
+const testAPIBaseURL = endpointBaseURL + "/v1"
+
+// newAPIClient returns a client serving fixed API responses keyed by endpoint path.
+func newAPIClient(responses map[string]string) (*Client, *cdnTransport) {
+	byURL := make(map[string][]byte, len(responses))
+	for path, body := range responses {
+		byURL[testAPIBaseURL+path] = []byte(body)
+	}
+	c, transport := newCDNClient(byURL)
+	c.baseURL = testAPIBaseURL
+	return c, transport
+}
+
+func TestFindUserRoster(t *testing.T) {
+	rosters := []*Roster{
+		{RosterID: 1, OwnerID: "u1"},
+		{RosterID: 2, OwnerID: "u2", CoOwners: []string{"u3"}},
+		nil,
+	}
+
+	tt := []struct {
+		testcase         string
+		userID           string
+		expectedRosterID int
+	}{
+		{testcase: "owner", userID: "u2", expectedRosterID: 2},
+		{testcase: "co-owner", userID: "u3", expectedRosterID: 2},
+		{testcase: "not in league", userID: "u4"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testcase, func(t *testing.T) {
+			r := FindUserRoster(rosters, tc.userID)
+			if tc.expectedRosterID == 0 {
+				if r != nil {
+					t.Errorf("expected no roster, got %d", r.RosterID)
+				}
+				return
+			}
+			if r == nil || r.RosterID != tc.expectedRosterID {
+				t.Errorf("expected roster %d, got %+v", tc.expectedRosterID, r)
+			}
+		})
+	}
+}
+
+func TestGetUserPortfolio(t *testing.T) {
+	responses := map[string]string{
+		"/user/alice":               `{"user_id": "u1", "username": "alice"}`,
+		"/user/u1/leagues/nfl/2024": `[{"league_id": "l1", "name": "Dynasty", "season": "2024", "status": "complete"}, {"league_id": "l2", "name": "Best Ball", "season": "2024", "status": "complete"}]`,
+		"/user/u1/leagues/nfl/2025": `[{"league_id": "l3", "name": "Dynasty", "season": "2025", "status": "in_season"}, {"league_id": "l3", "name": "Dynasty", "season": "2025", "status": "in_season"}]`,
+		"/user/u1/leagues/nba/2024": `[]`,
+		"/user/u1/leagues/nba/2025": `[{"league_id": "l4", "name": "Hoops", "season": "2025", "status": "drafting"}]`,
+		"/league/l1/rosters":        `[{"roster_id": 1, "owner_id": "u1"}, {"roster_id": 2, "owner_id": "u2"}]`,
+		"/league/l2/rosters":        `[{"roster_id": 3, "owner_id": "u2", "co_owners": ["u1"]}]`,
+		"/league/l3/rosters":        `[{"roster_id": 4, "owner_id": "u1"}]`,
+		"/league/l4/rosters":        `[{"roster_id": 1, "owner_id": "u9"}]`,
+	}
+
+	tt := []struct {
+		testcase   string
+		username   string
+		options    PortfolioOptions
+		shouldPass bool
+	}{
+		{testcase: "sports and seasons", username: "alice", options: PortfolioOptions{Sports: []sport{SportNFL, SportNBA}, FromSeason: 2024, ToSeason: 2025}, shouldPass: true},
+		{testcase: "missing username", username: " ", options: PortfolioOptions{}, shouldPass: false},
+		{testcase: "reversed seasons", username: "alice", options: PortfolioOptions{FromSeason: 2025, ToSeason: 2024}, shouldPass: false},
+		{testcase: "unknown user", username: "bob", options: PortfolioOptions{FromSeason: 2024, ToSeason: 2024}, shouldPass: false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testcase, func(t *testing.T) {
+			c, _ := newAPIClient(responses)
+			p, err := c.GetUserPortfolio(context.Background(), tc.username, tc.options)
+			if err != nil {
+				if tc.shouldPass {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				t.Logf("expected error: %v", err)
+				return
+			}
+			if !tc.shouldPass {
+				t.Fatalf("expected failure but got %+v", p)
+			}
+
+			if p.User.UserID != "u1" {
+				t.Errorf("expected user u1, got %s", p.User.UserID)
+			}
+
+			var ids []string
+			for _, pl := range p.Leagues {
+				ids = append(ids, pl.League.LeagueID)
+			}
+			expectedIDs := []string{"l4", "l3", "l2", "l1"}
+			if len(ids) != len(expectedIDs) {
+				t.Fatalf("expected leagues %v, got %v", expectedIDs, ids)
+			}
+			for i := range ids {
+				if ids[i] != expectedIDs[i] {
+					t.Errorf("expected leagues %v, got %v", expectedIDs, ids)
+					break
+				}
+			}
+
+			if len(p.Groups) != 3 {
+				t.Fatalf("expected 3 groups, got %d", len(p.Groups))
+			}
+			if g := p.Group(SportNFL, "2024"); len(g) != 2 {
+				t.Errorf("expected 2 leagues in nfl 2024, got %d", len(g))
+			}
+
+			rosters := map[string]int{}
+			for _, pl := range p.Leagues {
+				if pl.Roster != nil {
+					rosters[pl.League.LeagueID] = pl.Roster.RosterID
+				}
+			}
+			for leagueID, rosterID := range map[string]int{"l1": 1, "l2": 3, "l3": 4} {
+				if rosters[leagueID] != rosterID {
+					t.Errorf("expected roster %d in league %s, got %d", rosterID, leagueID, rosters[leagueID])
+				}
+			}
+			if _, ok := rosters["l4"]; ok {
+				t.Errorf("expected no roster in league l4")
+			}
+			if status := p.Group(SportNBA, "2025")[0].Status; status != "drafting" {
+				t.Errorf("expected status drafting, got %s", status)
+			}
+		})
+	}
+}
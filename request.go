@@ -19,6 +19,25 @@ func (e *APIError) Error() string {
 }
 
 func (c *Client) getRequest(ctx context.Context, endpoint string) ([]byte, error) {
+	res, err := c.getRequestWithHeaders(ctx, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.body, nil
+}
+
+// response holds a completed request's status, headers and body.
+type response struct {
+	statusCode int
+	header     http.Header
+	body       []byte
+}
+
+// getRequestWithHeaders sends a GET request with additional request headers. A 304 Not Modified
+// response is returned without error only when the request was conditional, i.e. it sent If-None-Match or
+// If-Modified-Since.
+func (c *Client) getRequestWithHeaders(ctx context.Context, endpoint string, headers map[string]string) (*response, error) {
 	if c.client == nil {
 		return nil, errors.New("http client is not initialised")
 	}
@@ -32,6 +51,9 @@ func (c *Client) getRequest(ctx context.Context, endpoint string) ([]byte, error
 	if err != nil {
 		return nil, fmt.Errorf("creating request: %w", err)
 	}
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
 
 	res, err := c.client.Do(req)
 	if err != nil {
@@ -44,9 +66,10 @@ func (c *Client) getRequest(ctx context.Context, endpoint string) ([]byte, error
 		return nil, fmt.Errorf("reading response: %w", err)
 	}
 
-	// Return successful responses
-	if res.StatusCode >= http.StatusOK && res.StatusCode < http.StatusMultipleChoices {
-		return b, nil
+	// Return successful responses, and not modified responses to conditional requests
+	conditional := req.Header.Get("If-None-Match") != "" || req.Header.Get("If-Modified-Since") != ""
+	if (res.StatusCode >= http.StatusOK && res.StatusCode < http.StatusMultipleChoices) || (res.StatusCode == http.StatusNotModified && conditional) {
+		return &response{statusCode: res.StatusCode, header: res.Header, body: b}, nil
 	}
 
 	return nil, &APIError{
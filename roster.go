@@ -23,34 +23,55 @@ type Roster struct {
 	Settings *RosterSettings `json:"settings,omitempty"`
 	Starters []string        `json:"starters,omitempty"`
 	Taxi     []string        `json:"taxi,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // RosterSettings contains scoring and record settings for a roster.
 type RosterSettings struct {
-	Fpts               int `json:"fpts,omitempty"`
-	FptsAgainst        int `json:"fpts_against,omitempty"`
-	FptsAgainstDecimal int `json:"fpts_against_decimal,omitempty"`
-	FptsDecimal        int `json:"fpts_decimal,omitempty"`
-	Losses             int `json:"losses,omitempty"`
-	Ppts               int `json:"ppts,omitempty"`
-	PptsDecimal        int `json:"ppts_decimal,omitempty"`
-	Ties               int `json:"ties,omitempty"`
-	TotalMoves         int `json:"total_moves,omitempty"`
-	WaiverBudgetUsed   int `json:"waiver_budget_used,omitempty"`
-	WaiverPosition     int `json:"waiver_position,omitempty"`
-	Wins               int `json:"wins,omitempty"`
+	Fpts               FlexInt `json:"fpts,omitempty"`
+	FptsAgainst        FlexInt `json:"fpts_against,omitempty"`
+	FptsAgainstDecimal FlexInt `json:"fpts_against_decimal,omitempty"`
+	FptsDecimal        FlexInt `json:"fpts_decimal,omitempty"`
+	Losses             FlexInt `json:"losses,omitempty"`
+	Ppts               FlexInt `json:"ppts,omitempty"`
+	PptsDecimal        FlexInt `json:"ppts_decimal,omitempty"`
+	Ties               FlexInt `json:"ties,omitempty"`
+	TotalMoves         FlexInt `json:"total_moves,omitempty"`
+	WaiverBudgetUsed   FlexInt `json:"waiver_budget_used,omitempty"`
+	WaiverPosition     FlexInt `json:"waiver_position,omitempty"`
+	Wins               FlexInt `json:"wins,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// Points returns the roster's points for, combining the whole and decimal parts.
+func (s *RosterSettings) Points() float64 {
+	return float64(s.Fpts.Int()) + float64(s.FptsDecimal.Int())/100
+}
+
+// PointsAgainst returns the roster's points against, combining the whole and decimal parts.
+func (s *RosterSettings) PointsAgainst() float64 {
+	return float64(s.FptsAgainst.Int()) + float64(s.FptsAgainstDecimal.Int())/100
 }
 
 // RosterMetadata contains metadata and player nicknames for a roster.
 type RosterMetadata struct {
-	AllowPnNews                   string            `json:"allow_pn_news,omitempty"`
-	AllowPnScoring                string            `json:"allow_pn_scoring,omitempty"`
-	AllowPnInactiveStarters       string            `json:"allow_pn_inactive_starters,omitempty"`
-	AllowPnPlayerInjuryStatus     string            `json:"allow_pn_player_injury_status,omitempty"`
-	RestrictPnScoringStartersOnly string            `json:"restrict_pn_scoring_starters_only,omitempty"`
+	AllowPnNews                   FlexBool          `json:"allow_pn_news,omitempty"`
+	AllowPnScoring                FlexBool          `json:"allow_pn_scoring,omitempty"`
+	AllowPnInactiveStarters       FlexBool          `json:"allow_pn_inactive_starters,omitempty"`
+	AllowPnPlayerInjuryStatus     FlexBool          `json:"allow_pn_player_injury_status,omitempty"`
+	RestrictPnScoringStartersOnly FlexBool          `json:"restrict_pn_scoring_starters_only,omitempty"`
 	Record                        string            `json:"record,omitempty"`
 	Streak                        string            `json:"streak,omitempty"`
 	PlayerNicknames               map[string]string `json:"-"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// isDynamicField reports whether a key is a player nickname, which UnmarshalJSON decodes into PlayerNicknames.
+func (rm *RosterMetadata) isDynamicField(key string) bool {
+	return strings.HasPrefix(key, playerNicknamePrefix)
 }
 
 // UnmarshalJSON handles both regular fields and dynamic playerNickname fields
@@ -0,0 +1,208 @@
+package sleeper
+
+import (
+	"fmt"
+	"slices"
+)
+
+type rosterViolationType string
+
+const (
+	RosterViolationStarterCount      rosterViolationType = "starter_count"
+	RosterViolationSlotIneligible    rosterViolationType = "slot_ineligible"
+	RosterViolationReserveCount      rosterViolationType = "reserve_count"
+	RosterViolationReserveIneligible rosterViolationType = "reserve_ineligible"
+	RosterViolationTaxiCount         rosterViolationType = "taxi_count"
+	RosterViolationTaxiIneligible    rosterViolationType = "taxi_ineligible"
+)
+
+const (
+	rosterSlotBench   = "BN"
+	rosterSlotReserve = "IR"
+	rosterSlotTaxi    = "TAXI"
+
+	emptyStarterID = "0" // Sleeper's placeholder for an empty starting slot
+)
+
+// Injury statuses as reported in Player.InjuryStatus.
+const (
+	injuryStatusDoubtful  = "Doubtful"
+	injuryStatusOut       = "Out"
+	injuryStatusIR        = "IR"
+	injuryStatusPUP       = "PUP"
+	injuryStatusSuspended = "Sus"
+	injuryStatusCOVID     = "COV"
+	injuryStatusNotActive = "NA"
+	injuryStatusDNR       = "DNR"
+)
+
+// rosterSlotPositions maps flex roster slots to the positions eligible to fill them.
+// Any other slot is filled only by players with the matching position.
+var rosterSlotPositions = map[string][]string{
+	"FLEX":       {"RB", "WR", "TE"},
+	"WRRB_FLEX":  {"WR", "RB"},
+	"REC_FLEX":   {"WR", "TE"},
+	"SUPER_FLEX": {"QB", "RB", "WR", "TE"},
+	"IDP_FLEX":   {"DL", "LB", "DB"},
+	"G":          {"PG", "SG"},
+	"F":          {"SF", "PF"},
+	"UTIL":       {"PG", "SG", "SF", "PF", "C"},
+}
+
+// RosterViolation describes a single way in which a roster breaks its league's rules.
+type RosterViolation struct {
+	Type     rosterViolationType `json:"type"`
+	RosterID int                 `json:"roster_id"`
+	OwnerID  string              `json:"owner_id,omitempty"`
+	Slot     string              `json:"slot,omitempty"`
+	PlayerID string              `json:"player_id,omitempty"`
+	Message  string              `json:"message"`
+}
+
+// SlotEligible reports whether a player with the given fantasy positions may fill a roster slot.
+func SlotEligible(slot string, fantasyPositions []string) bool {
+	eligible, ok := rosterSlotPositions[slot]
+	if !ok {
+		eligible = []string{slot}
+	}
+	for _, position := range fantasyPositions {
+		if slices.Contains(eligible, position) {
+			return true
+		}
+	}
+	return false
+}
+
+// StarterSlots returns the league's starting roster slots, in the order used by Roster.Starters.
+func (l *League) StarterSlots() []string {
+	var slots []string
+	for _, slot := range l.RosterPositions {
+		switch slot {
+		case rosterSlotBench, rosterSlotReserve, rosterSlotTaxi:
+			continue
+		}
+		slots = append(slots, slot)
+	}
+	return slots
+}
+
+// ValidateRoster checks a roster against its league's starting slots, reserve (IR) rules and taxi squad rules,
+// using the player catalog (see ListNFLPlayers) for positions, injury status and experience. Players missing
+// from the catalog are not checked for eligibility.
+func ValidateRoster(league *League, roster *Roster, players map[string]Player) []*RosterViolation {
+	if league == nil || roster == nil {
+		return nil
+	}
+
+	var violations []*RosterViolation
+	add := func(violationType rosterViolationType, slot, playerID, message string) {
+		violations = append(violations, &RosterViolation{
+			Type:     violationType,
+			RosterID: roster.RosterID,
+			OwnerID:  roster.OwnerID,
+			Slot:     slot,
+			PlayerID: playerID,
+			Message:  message,
+		})
+	}
+
+	slots := league.StarterSlots()
+	if len(roster.Starters) != len(slots) {
+		add(RosterViolationStarterCount, "", "", fmt.Sprintf("roster has %d starters, league requires %d", len(roster.Starters), len(slots)))
+	}
+	for i, playerID := range roster.Starters {
+		if i >= len(slots) || playerID == "" || playerID == emptyStarterID {
+			continue
+		}
+		player, ok := players[playerID]
+		if !ok {
+			continue
+		}
+		if !SlotEligible(slots[i], player.FantasyPositions) {
+			add(RosterViolationSlotIneligible, slots[i], playerID, fmt.Sprintf("%s is not eligible for the %s slot", playerName(player, playerID), slots[i]))
+		}
+	}
+
+	settings := league.Settings
+	if settings == nil {
+		settings = &Settings{}
+	}
+
+	if len(roster.Reserve) > settings.ReserveSlots.Int() {
+		add(RosterViolationReserveCount, rosterSlotReserve, "", fmt.Sprintf("roster has %d players on IR, league allows %d", len(roster.Reserve), settings.ReserveSlots))
+	}
+	for _, playerID := range roster.Reserve {
+		player, ok := players[playerID]
+		if !ok {
+			continue
+		}
+		if !reserveEligible(settings, player.InjuryStatus) {
+			status := "healthy"
+			if player.InjuryStatus != nil && *player.InjuryStatus != "" {
+				status = *player.InjuryStatus
+			}
+			add(RosterViolationReserveIneligible, rosterSlotReserve, playerID, fmt.Sprintf("%s (%s) is not eligible for IR", playerName(player, playerID), status))
+		}
+	}
+
+	if len(roster.Taxi) > settings.TaxiSlots.Int() {
+		add(RosterViolationTaxiCount, rosterSlotTaxi, "", fmt.Sprintf("roster has %d players on the taxi squad, league allows %d", len(roster.Taxi), settings.TaxiSlots))
+	}
+	for _, playerID := range roster.Taxi {
+		player, ok := players[playerID]
+		if !ok {
+			continue
+		}
+		if settings.TaxiAllowVets == 0 && settings.TaxiYears > 0 && player.YearsExp.Int() >= settings.TaxiYears.Int() {
+			add(RosterViolationTaxiIneligible, rosterSlotTaxi, playerID, fmt.Sprintf("%s has %d years of experience, taxi squad allows fewer than %d", playerName(player, playerID), player.YearsExp, settings.TaxiYears))
+		}
+	}
+
+	return violations
+}
+
+// ValidateLeagueRosters validates every roster in a league and returns all violations.
+func ValidateLeagueRosters(league *League, rosters []*Roster, players map[string]Player) []*RosterViolation {
+	var violations []*RosterViolation
+	for _, roster := range rosters {
+		violations = append(violations, ValidateRoster(league, roster, players)...)
+	}
+	return violations
+}
+
+// reserveEligible reports whether a player with the given injury status may be placed on IR.
+func reserveEligible(settings *Settings, injuryStatus *string) bool {
+	if injuryStatus == nil {
+		return false
+	}
+
+	switch *injuryStatus {
+	case injuryStatusIR, injuryStatusPUP:
+		return true
+	case injuryStatusOut:
+		return settings.ReserveAllowOut != 0
+	case injuryStatusDoubtful:
+		return settings.ReserveAllowDoubtful != 0
+	case injuryStatusSuspended:
+		return settings.ReserveAllowSus != 0
+	case injuryStatusCOVID:
+		return settings.ReserveAllowCov != 0
+	case injuryStatusNotActive:
+		return settings.ReserveAllowNA != 0
+	case injuryStatusDNR:
+		return settings.ReserveAllowDNR != 0
+	default:
+		return false
+	}
+}
+
+// playerName returns a player's full name, falling back to the player ID.
+func playerName(player Player, playerID string) string {
+	if player.FullName != "" {
+		return player.FullName
+	}
+	if name := player.FirstName + " " + player.LastName; name != " " {
+		return name
+	}
+	return playerID
+}
@@ -0,0 +1,80 @@
+package sleeper
+
+import (
+	"testing"
+)
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func TestRoster_Validate(t *testing.T) {
+	league := &League{
+		RosterPositions: []string{"QB", "RB", "FLEX", "SUPER_FLEX", "BN", "BN", "IR"},
+		Settings: &Settings{
+			ReserveSlots:    1,
+			ReserveAllowOut: 1,
+			TaxiSlots:       1,
+			TaxiYears:       2,
+		},
+	}
+	players := map[string]Player{
+		"qb":     {FullName: "Quarter Back", FantasyPositions: []string{"QB"}},
+		"rb":     {FullName: "Running Back", FantasyPositions: []string{"RB"}},
+		"wr":     {FullName: "Wide Receiver", FantasyPositions: []string{"WR"}},
+		"k":      {FullName: "Kicker", FantasyPositions: []string{"K"}},
+		"out":    {FullName: "Out Player", FantasyPositions: []string{"WR"}, InjuryStatus: strPtr("Out")},
+		"q":      {FullName: "Questionable Player", FantasyPositions: []string{"WR"}, InjuryStatus: strPtr("Questionable")},
+		"doubt":  {FullName: "Doubtful Player", FantasyPositions: []string{"WR"}, InjuryStatus: strPtr("Doubtful")},
+		"rookie": {FullName: "Rookie", FantasyPositions: []string{"WR"}, YearsExp: 0},
+		"vet":    {FullName: "Veteran", FantasyPositions: []string{"WR"}, YearsExp: 5},
+	}
+
+	tt := []struct {
+		testcase           string
+		roster             *Roster
+		expectedViolations []rosterViolationType
+	}{
+		{
+			"legal roster",
+			&Roster{Starters: []string{"qb", "rb", "wr", "0"}, Reserve: []string{"out"}, Taxi: []string{"rookie"}},
+			nil,
+		},
+		{
+			"wrong starter count",
+			&Roster{Starters: []string{"qb", "rb", "wr"}},
+			[]rosterViolationType{RosterViolationStarterCount},
+		},
+		{
+			"ineligible starters",
+			&Roster{Starters: []string{"rb", "rb", "k", "wr"}},
+			[]rosterViolationType{RosterViolationSlotIneligible, RosterViolationSlotIneligible},
+		},
+		{
+			"ineligible and too many on IR",
+			&Roster{Starters: []string{"qb", "rb", "wr", "wr"}, Reserve: []string{"q", "doubt"}},
+			[]rosterViolationType{RosterViolationReserveCount, RosterViolationReserveIneligible, RosterViolationReserveIneligible},
+		},
+		{
+			"veteran on taxi",
+			&Roster{Starters: []string{"qb", "rb", "wr", "wr"}, Taxi: []string{"vet", "rookie"}},
+			[]rosterViolationType{RosterViolationTaxiCount, RosterViolationTaxiIneligible},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testcase, func(t *testing.T) {
+			violations := ValidateRoster(league, tc.roster, players)
+			if len(violations) != len(tc.expectedViolations) {
+				t.Errorf("expected %d violations, got %d: %+v", len(tc.expectedViolations), len(violations), violations)
+				return
+			}
+
+			for i, v := range violations {
+				if v.Type != tc.expectedViolations[i] {
+					t.Errorf("expected violation %s, got %s (%s)", tc.expectedViolations[i], v.Type, v.Message)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,206 @@
+package sleeper
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// defaultScheduleData is the bundled NFL team and bye week data for the 2024 and 2025 seasons. Seasons
+// not bundled can be supplied with LoadSchedule or LoadScheduleFile; lookups for them return an error.
+//
+//go:embed data/nfl_schedule.json
+var defaultScheduleData []byte
+
+// Team represents a professional sports team. Abbr matches Player.Team.
+type Team struct {
+	Abbr       string `json:"abbr"`
+	Name       string `json:"name"`
+	Conference string `json:"conference,omitempty"`
+	Division   string `json:"division,omitempty"`
+}
+
+// Schedule holds a sport's teams and their bye weeks by season.
+type Schedule struct {
+	Sport string                      `json:"sport"`
+	Teams []*Team                     `json:"teams"`
+	Byes  map[string]map[int][]string `json:"byes"` // season -> week -> team abbreviations on bye
+}
+
+// DefaultSchedule returns the bundled NFL schedule data, which has bye weeks for the 2024 and 2025 seasons
+// only. Bye lookups for any other season return an error; load a file with that season's byes with
+// LoadSchedule or LoadScheduleFile instead.
+func DefaultSchedule() (*Schedule, error) {
+	return LoadSchedule(bytes.NewReader(defaultScheduleData))
+}
+
+// LoadSchedule reads schedule data in the same JSON format as the bundled data file.
+func LoadSchedule(r io.Reader) (*Schedule, error) {
+	var schedule *Schedule
+	if err := json.NewDecoder(r).Decode(&schedule); err != nil {
+		return nil, fmt.Errorf("unmarshaling schedule: %w", err)
+	}
+	if schedule == nil {
+		return nil, errors.New("schedule not found")
+	}
+	if schedule.Byes == nil {
+		schedule.Byes = make(map[string]map[int][]string)
+	}
+
+	return schedule, nil
+}
+
+// LoadScheduleFile reads schedule data from a JSON file.
+func LoadScheduleFile(path string) (*Schedule, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening schedule: %w", err)
+	}
+	defer f.Close()
+
+	return LoadSchedule(f)
+}
+
+// Team returns the team with the given abbreviation, or nil.
+func (s *Schedule) Team(abbr string) *Team {
+	for _, t := range s.Teams {
+		if strings.EqualFold(t.Abbr, abbr) {
+			return t
+		}
+	}
+	return nil
+}
+
+// seasonByes returns a season's bye weeks, or an error if the schedule has no bye data for the season.
+func (s *Schedule) seasonByes(season string) (map[int][]string, error) {
+	byes, ok := s.Byes[season]
+	if !ok {
+		return nil, fmt.Errorf("no bye weeks for season %s", season)
+	}
+	return byes, nil
+}
+
+// ByeWeek returns the bye week of a team in a season. It returns an error if the season has no bye data or
+// the team has no bye in it.
+func (s *Schedule) ByeWeek(season, team string) (int, error) {
+	byes, err := s.seasonByes(season)
+	if err != nil {
+		return 0, err
+	}
+	for week, teams := range byes {
+		if slices.Contains(teams, team) {
+			return week, nil
+		}
+	}
+	return 0, fmt.Errorf("no bye week for %s in season %s", team, season)
+}
+
+// TeamsOnBye returns the abbreviations of the teams on bye in a season and week. It returns an error if the
+// season has no bye data.
+func (s *Schedule) TeamsOnBye(season string, week int) ([]string, error) {
+	byes, err := s.seasonByes(season)
+	if err != nil {
+		return nil, err
+	}
+	teams := slices.Clone(byes[week])
+	sort.Strings(teams)
+	return teams, nil
+}
+
+// ByeTeams returns the teams on bye in a season and week as a set, for use with StarterCheckOptions. It
+// returns an error if the season has no bye data.
+func (s *Schedule) ByeTeams(season string, week int) (map[string]bool, error) {
+	teams, err := s.TeamsOnBye(season, week)
+	if err != nil {
+		return nil, err
+	}
+	byes := make(map[string]bool, len(teams))
+	for _, team := range teams {
+		byes[team] = true
+	}
+	return byes, nil
+}
+
+// ByeWeekConflict lists a roster's players on bye in a single week.
+type ByeWeekConflict struct {
+	Week           int            `json:"week"`
+	Teams          []string       `json:"teams"`           // teams on bye
+	Players        []string       `json:"players"`         // rostered players on bye
+	Starters       []string       `json:"starters"`        // current starters on bye
+	PositionCounts map[string]int `json:"position_counts"` // players on bye by position
+}
+
+// RosterByeReport lists the weeks in which a roster has players on bye.
+type RosterByeReport struct {
+	RosterID int                `json:"roster_id"`
+	OwnerID  string             `json:"owner_id,omitempty"`
+	Weeks    []*ByeWeekConflict `json:"weeks"` // sorted by week
+}
+
+// Week returns the conflicts for a week, or nil if the roster has no players on bye that week.
+func (r *RosterByeReport) Week(week int) *ByeWeekConflict {
+	for _, w := range r.Weeks {
+		if w.Week == week {
+			return w
+		}
+	}
+	return nil
+}
+
+// ByeConflicts joins each roster's players (see ListNFLPlayers for the catalog) against the season's
+// bye weeks and reports, per roster, every week in which rostered players are on bye.
+func (s *Schedule) ByeConflicts(season string, rosters []*Roster, players map[string]Player) ([]*RosterByeReport, error) {
+	byes, err := s.seasonByes(season)
+	if err != nil {
+		return nil, err
+	}
+
+	weeks := make([]int, 0, len(byes))
+	teamsOnBye := make(map[int][]string, len(byes))
+	for week, teams := range byes {
+		weeks = append(weeks, week)
+		teamsOnBye[week] = slices.Sorted(slices.Values(teams))
+	}
+	sort.Ints(weeks)
+
+	var reports []*RosterByeReport
+	for _, roster := range rosters {
+		if roster == nil {
+			continue
+		}
+
+		report := &RosterByeReport{RosterID: roster.RosterID, OwnerID: roster.OwnerID}
+		for _, week := range weeks {
+			conflict := &ByeWeekConflict{
+				Week:           week,
+				Teams:          teamsOnBye[week],
+				PositionCounts: make(map[string]int),
+			}
+			for _, playerID := range roster.Players {
+				player, ok := players[playerID]
+				if !ok || player.Team == nil || !slices.Contains(conflict.Teams, *player.Team) {
+					continue
+				}
+				conflict.Players = append(conflict.Players, playerID)
+				conflict.PositionCounts[player.Position]++
+				if slices.Contains(roster.Starters, playerID) {
+					conflict.Starters = append(conflict.Starters, playerID)
+				}
+			}
+			if len(conflict.Players) > 0 {
+				report.Weeks = append(report.Weeks, conflict)
+			}
+		}
+
+		reports = append(reports, report)
+	}
+
+	return reports, nil
+}
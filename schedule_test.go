@@ -0,0 +1,147 @@
+package sleeper
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSchedule_Default(t *testing.T) {
+	schedule, err := DefaultSchedule()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(schedule.Teams) != 32 {
+		t.Errorf("expected 32 teams, got %d", len(schedule.Teams))
+	}
+
+	for season, weeks := range schedule.Byes {
+		seen := make(map[string]bool)
+		for _, teams := range weeks {
+			for _, team := range teams {
+				if schedule.Team(team) == nil {
+					t.Errorf("season %s: unknown team %s", season, team)
+				}
+				if seen[team] {
+					t.Errorf("season %s: team %s has more than one bye", season, team)
+				}
+				seen[team] = true
+			}
+		}
+		if len(seen) != len(schedule.Teams) {
+			t.Errorf("season %s: expected every team to have a bye, got %d", season, len(seen))
+		}
+	}
+}
+
+func TestSchedule_Load(t *testing.T) {
+	tt := []struct {
+		testcase   string
+		data       string
+		season     string
+		team       string
+		expectedBy int
+		shouldPass bool
+	}{
+		{
+			"user supplied schedule",
+			`{"sport": "nfl", "teams": [{"abbr": "KC", "name": "Kansas City Chiefs"}], "byes": {"2030": {"7": ["KC"]}}}`,
+			"2030",
+			"KC",
+			7,
+			true,
+		},
+		{
+			"season without bye data",
+			`{"sport": "nfl", "teams": [{"abbr": "KC", "name": "Kansas City Chiefs"}], "byes": {"2030": {"7": ["KC"]}}}`,
+			"2031",
+			"KC",
+			0,
+			false,
+		},
+		{
+			"team without a bye",
+			`{"sport": "nfl", "teams": [{"abbr": "KC", "name": "Kansas City Chiefs"}], "byes": {"2030": {"7": ["KC"]}}}`,
+			"2030",
+			"SF",
+			0,
+			false,
+		},
+		{
+			"invalid data",
+			`{"byes": []}`,
+			"",
+			"",
+			0,
+			false,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testcase, func(t *testing.T) {
+			schedule, err := LoadSchedule(strings.NewReader(tc.data))
+			var week int
+			if err == nil {
+				week, err = schedule.ByeWeek(tc.season, tc.team)
+			}
+			if err != nil {
+				if tc.shouldPass {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+				t.Logf("expected error: %v", err)
+				return
+			}
+
+			if !tc.shouldPass {
+				t.Errorf("expected failure but got bye week: %d", week)
+				return
+			}
+
+			if week != tc.expectedBy {
+				t.Errorf("expected bye week %d, got %d", tc.expectedBy, week)
+				return
+			}
+		})
+	}
+}
+
+func TestSchedule_ByeConflicts(t *testing.T) {
+	schedule, err := LoadSchedule(strings.NewReader(`{"byes": {"2030": {"5": ["KC", "SF"], "9": ["DAL"]}}}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	players := map[string]Player{
+		"qb": {Position: "QB", Team: strPtr("KC")},
+		"rb": {Position: "RB", Team: strPtr("SF")},
+		"wr": {Position: "WR", Team: strPtr("DAL")},
+		"fa": {Position: "WR"},
+	}
+	rosters := []*Roster{
+		{RosterID: 1, Players: []string{"qb", "rb", "wr", "fa"}, Starters: []string{"qb", "wr"}},
+	}
+
+	if _, err := schedule.ByeConflicts("2031", rosters, players); err == nil {
+		t.Errorf("expected error for season without bye data")
+	}
+
+	reports, err := schedule.ByeConflicts("2030", rosters, players)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	week5 := reports[0].Week(5)
+	if week5 == nil || len(week5.Players) != 2 || len(week5.Starters) != 1 || week5.PositionCounts["RB"] != 1 {
+		t.Errorf("unexpected week 5 conflicts: %+v", week5)
+	}
+	if week9 := reports[0].Week(9); week9 == nil || week9.Starters[0] != "wr" {
+		t.Errorf("unexpected week 9 conflicts: %+v", week9)
+	}
+	if byes, err := schedule.ByeTeams("2030", 5); err != nil || !byes["SF"] {
+		t.Errorf("expected SF to be on bye in week 5, got %v, %v", byes, err)
+	}
+	if _, err := schedule.TeamsOnBye("2031", 5); err == nil {
+		t.Errorf("expected error for season without bye data")
+	}
+}
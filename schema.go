@@ -0,0 +1,392 @@
+package sleeper
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	schemaKindObject = "object"
+	schemaKindArray  = "array"
+	schemaKindString = "string"
+	schemaKindNumber = "number"
+	schemaKindBool   = "bool"
+	schemaKindNull   = "null"
+
+	// Models keep the JSON fields they do not declare in an Extra map[string]json.RawMessage field tagged
+	// `json:"-"`. It is populated by decode when Config.CaptureUnknownFields is set and is nil otherwise.
+	extraFieldName = "Extra"
+)
+
+var (
+	jsonUnmarshalerType = reflect.TypeFor[json.Unmarshaler]()
+	dynamicFieldsType   = reflect.TypeFor[dynamicFields]()
+	extraFieldType      = reflect.TypeFor[map[string]json.RawMessage]()
+)
+
+// dynamicFields is implemented by models whose UnmarshalJSON decodes keys that are not struct fields, such as
+// RosterMetadata's player nicknames. Unlike other json.Unmarshaler types they are walked as structs, with
+// those keys treated as known.
+type dynamicFields interface {
+	isDynamicField(key string) bool
+}
+
+// FieldTypeChange describes a field whose JSON type differs from the previous response.
+type FieldTypeChange struct {
+	Path     string `json:"path"`
+	Previous string `json:"previous"`
+	Current  string `json:"current"`
+}
+
+// SchemaDrift reports how a response's fields differ from the model it is decoded into. Added and removed
+// fields are compared with the fields the model declares and reported once per endpoint; a removed field is
+// one the model declares that no object at its path held. Type changes are compared with the previous
+// response for the endpoint. Paths are dot separated; array elements are written as "[]" and map keys
+// (such as player IDs) as "*".
+type SchemaDrift struct {
+	Endpoint string             `json:"endpoint"`          // endpoint template, e.g. "/league/%s"
+	Added    []string           `json:"added,omitempty"`   // fields in the response that the model does not declare
+	Removed  []string           `json:"removed,omitempty"` // fields the model declares that are missing from the response
+	Changed  []*FieldTypeChange `json:"changed,omitempty"`
+}
+
+// schemaTracker holds the field types last seen for each endpoint and the drift already reported.
+type schemaTracker struct {
+	mu      sync.Mutex
+	schemas map[string]map[string]string // endpoint -> path -> JSON kinds
+	added   map[string]map[string]bool   // endpoint -> added paths reported
+	removed map[string]map[string]bool   // endpoint -> removed paths reported
+}
+
+// decode unmarshals a response body into v. When unknown field capture or schema drift detection is
+// enabled, the body is also walked alongside v to populate Extra fields and compare the response's fields
+// with the model's. Drift is reported even when v fails to decode, as a changed field type is the usual
+// cause.
+func (c *Client) decode(endpointTemplate string, by []byte, v any) error {
+	err := json.Unmarshal(by, v)
+	if !c.captureUnknownFields && c.schemaDriftHook == nil {
+		return err
+	}
+
+	var data any
+	dec := json.NewDecoder(bytes.NewReader(by))
+	dec.UseNumber()
+	if dec.Decode(&data) != nil {
+		return err
+	}
+
+	w := &schemaWalker{
+		capture:  c.captureUnknownFields,
+		fields:   make(map[string]map[string]bool),
+		declared: make(map[string]bool),
+	}
+	w.walk(data, reflect.ValueOf(v), "")
+
+	if c.schemaDriftHook != nil {
+		if drift := c.schemas.diff(endpointTemplate, w.schema(), w.unknown, w.missing()); drift != nil {
+			c.schemaDriftHook(drift)
+		}
+	}
+	return err
+}
+
+// schemaWalker walks decoded JSON alongside the Go value it was unmarshaled into.
+type schemaWalker struct {
+	capture  bool
+	fields   map[string]map[string]bool // path -> JSON kinds seen
+	declared map[string]bool            // declared field path -> whether any object held it
+	unknown  []string
+}
+
+func (w *schemaWalker) record(path, kind string) {
+	if w.fields[path] == nil {
+		w.fields[path] = make(map[string]bool)
+	}
+	w.fields[path][kind] = true
+}
+
+// walk records the schema of data at path. v is the Go value data was decoded into, or the zero Value when
+// there is no model for it. Types implementing json.Unmarshaler decode themselves and are treated as leaves,
+// unless they implement dynamicFields.
+func (w *schemaWalker) walk(data any, v reflect.Value, path string) {
+	for v.IsValid() && (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			v = reflect.Value{}
+			break
+		}
+		v = v.Elem()
+	}
+	if v.IsValid() && implements(v, jsonUnmarshalerType) && !implements(v, dynamicFieldsType) {
+		w.record(path, schemaKind(data))
+		return
+	}
+
+	switch d := data.(type) {
+	case map[string]any:
+		w.record(path, schemaKindObject)
+		switch {
+		case v.IsValid() && v.Kind() == reflect.Struct:
+			w.walkStruct(d, v, path)
+		case v.IsValid() && v.Kind() == reflect.Map:
+			w.walkMap(d, v, path)
+		default:
+			for key, value := range d {
+				w.walk(value, reflect.Value{}, schemaPath(path, key))
+			}
+		}
+	case []any:
+		w.record(path, schemaKindArray)
+		for i, value := range d {
+			elem := reflect.Value{}
+			if v.IsValid() && (v.Kind() == reflect.Slice || v.Kind() == reflect.Array) && i < v.Len() {
+				elem = v.Index(i)
+			}
+			w.walk(value, elem, path+"[]")
+		}
+	default:
+		w.record(path, schemaKind(data))
+	}
+}
+
+func (w *schemaWalker) walkStruct(data map[string]any, v reflect.Value, path string) {
+	fields := jsonFields(v.Type())
+	var dynamic dynamicFields
+	if v.CanAddr() {
+		dynamic, _ = v.Addr().Interface().(dynamicFields)
+	}
+	present := make(map[string]bool, len(data))
+	var extra map[string]json.RawMessage
+
+	for key, value := range data {
+		fieldPath := schemaPath(path, key)
+		if name, ok := jsonFieldName(fields, key); ok {
+			present[name] = true
+			w.walk(value, v.FieldByIndex(fields[name]), fieldPath)
+			continue
+		}
+		if dynamic != nil && dynamic.isDynamicField(key) {
+			w.walk(value, reflect.Value{}, fieldPath)
+			continue
+		}
+
+		w.unknown = append(w.unknown, fieldPath)
+		w.walk(value, reflect.Value{}, fieldPath)
+		if w.capture {
+			if raw, err := json.Marshal(value); err == nil {
+				if extra == nil {
+					extra = make(map[string]json.RawMessage)
+				}
+				extra[key] = raw
+			}
+		}
+	}
+
+	for name := range fields {
+		fieldPath := schemaPath(path, name)
+		w.declared[fieldPath] = w.declared[fieldPath] || present[name]
+	}
+
+	if extra != nil {
+		if f := v.FieldByName(extraFieldName); f.IsValid() && f.Type() == extraFieldType && f.CanSet() {
+			f.Set(reflect.ValueOf(extra))
+		}
+	}
+}
+
+func (w *schemaWalker) walkMap(data map[string]any, v reflect.Value, path string) {
+	keyPath := schemaPath(path, "*")
+	for key, value := range data {
+		k := reflect.ValueOf(key)
+		if v.Type().Key().Kind() != reflect.String {
+			w.walk(value, reflect.Value{}, keyPath)
+			continue
+		}
+		k = k.Convert(v.Type().Key())
+
+		elem := v.MapIndex(k)
+		if !elem.IsValid() {
+			w.walk(value, reflect.Value{}, keyPath)
+			continue
+		}
+
+		// Map elements are not addressable, so walk a copy and store it back to keep any captured fields.
+		copied := reflect.New(elem.Type()).Elem()
+		copied.Set(elem)
+		w.walk(value, copied, keyPath)
+		if w.capture {
+			v.SetMapIndex(k, copied)
+		}
+	}
+}
+
+// schema returns the kinds seen at each path. Null is ignored where a path also held other kinds.
+func (w *schemaWalker) schema() map[string]string {
+	schema := make(map[string]string, len(w.fields))
+	for path, kinds := range w.fields {
+		if len(kinds) > 1 {
+			delete(kinds, schemaKindNull)
+		}
+		names := make([]string, 0, len(kinds))
+		for kind := range kinds {
+			names = append(names, kind)
+		}
+		sort.Strings(names)
+		schema[path] = strings.Join(names, "|")
+	}
+	return schema
+}
+
+// missing returns the declared field paths that no object in the response held.
+func (w *schemaWalker) missing() []string {
+	var paths []string
+	for path, present := range w.declared {
+		if !present {
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}
+
+// diff reports a response's added and removed fields that have not been reported for the endpoint yet, and
+// the fields whose type differs from when the endpoint last returned them. It returns nil when there is
+// nothing to report.
+func (t *schemaTracker) diff(endpoint string, current map[string]string, added, removed []string) *SchemaDrift {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.schemas == nil {
+		t.schemas = make(map[string]map[string]string)
+		t.added = make(map[string]map[string]bool)
+		t.removed = make(map[string]map[string]bool)
+	}
+	if t.schemas[endpoint] == nil {
+		t.schemas[endpoint] = make(map[string]string, len(current))
+	}
+
+	drift := &SchemaDrift{Endpoint: endpoint}
+	previous := t.schemas[endpoint]
+	for path, kind := range current {
+		previousKind, ok := previous[path]
+		if ok && previousKind != kind && previousKind != schemaKindNull && kind != schemaKindNull {
+			drift.Changed = append(drift.Changed, &FieldTypeChange{Path: path, Previous: previousKind, Current: kind})
+		}
+		previous[path] = kind
+	}
+	drift.Added = unreported(t.added, endpoint, added)
+	drift.Removed = unreported(t.removed, endpoint, removed)
+
+	if len(drift.Added) == 0 && len(drift.Removed) == 0 && len(drift.Changed) == 0 {
+		return nil
+	}
+
+	sort.Strings(drift.Added)
+	sort.Strings(drift.Removed)
+	sort.Slice(drift.Changed, func(i, j int) bool {
+		return drift.Changed[i].Path < drift.Changed[j].Path
+	})
+	return drift
+}
+
+// unreported returns the paths not yet reported for an endpoint and marks them as reported.
+func unreported(reported map[string]map[string]bool, endpoint string, paths []string) []string {
+	if reported[endpoint] == nil {
+		reported[endpoint] = make(map[string]bool)
+	}
+
+	var fresh []string
+	for _, path := range paths {
+		if !reported[endpoint][path] {
+			reported[endpoint][path] = true
+			fresh = append(fresh, path)
+		}
+	}
+	return fresh
+}
+
+// implements reports whether a value or its address implements an interface.
+func implements(v reflect.Value, iface reflect.Type) bool {
+	return v.Type().Implements(iface) || (v.CanAddr() && v.Addr().Type().Implements(iface))
+}
+
+// jsonFieldName returns the name of the declared field a JSON key decodes into. Like encoding/json, it
+// prefers an exact match and falls back to a case-insensitive one.
+func jsonFieldName(fields map[string][]int, key string) (string, bool) {
+	if _, ok := fields[key]; ok {
+		return key, true
+	}
+	for name := range fields {
+		if strings.EqualFold(name, key) {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// jsonFields maps the JSON names of a struct's exported fields to their indexes. Fields of embedded structs
+// are promoted as encoding/json does.
+func jsonFields(t reflect.Type) map[string][]int {
+	fields := make(map[string][]int, t.NumField())
+	var promoted []map[string][]int
+	for i := range t.NumField() {
+		f := t.Field(i)
+		tag, hasTag := f.Tag.Lookup("json")
+		if tag == "-" {
+			continue
+		}
+		if f.Anonymous && !hasTag && f.Type.Kind() == reflect.Struct {
+			embedded := make(map[string][]int)
+			for name, index := range jsonFields(f.Type) {
+				embedded[name] = append([]int{i}, index...)
+			}
+			promoted = append(promoted, embedded)
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+
+		name := f.Name
+		if n, _, _ := strings.Cut(tag, ","); n != "" {
+			name = n
+		}
+		fields[name] = []int{i}
+	}
+
+	// Fields declared on the struct take precedence over promoted fields.
+	for _, embedded := range promoted {
+		for name, index := range embedded {
+			if _, ok := fields[name]; !ok {
+				fields[name] = index
+			}
+		}
+	}
+	return fields
+}
+
+func schemaKind(data any) string {
+	switch data.(type) {
+	case map[string]any:
+		return schemaKindObject
+	case []any:
+		return schemaKindArray
+	case string:
+		return schemaKindString
+	case json.Number, float64:
+		return schemaKindNumber
+	case bool:
+		return schemaKindBool
+	default:
+		return schemaKindNull
+	}
+}
+
+func schemaPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
@@ -0,0 +1,114 @@
+package sleeper
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+)
+
+func TestSchema_CaptureUnknownFields(t *testing.T) {
+	c := &Client{captureUnknownFields: true}
+
+	var league *League
+	data := `{"league_id": "1", "new_field": {"a": 1}, "settings": {"num_teams": 12, "new_setting": "x"}, "metadata": null}`
+	if err := c.decode(endpointLeague, []byte(data), &league); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if string(league.Extra["new_field"]) != `{"a":1}` {
+		t.Errorf("expected new_field to be captured, got %s", league.Extra["new_field"])
+	}
+	if string(league.Settings.Extra["new_setting"]) != `"x"` {
+		t.Errorf("expected new_setting to be captured, got %s", league.Settings.Extra["new_setting"])
+	}
+	if _, ok := league.Extra["league_id"]; ok {
+		t.Errorf("expected known fields not to be captured")
+	}
+
+	var players map[string]Player
+	if err := c.decode(endpointNFLPlayers, []byte(`{"4046": {"player_id": "4046", "headshot": "x.jpg"}}`), &players); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := players["4046"].Extra["headshot"]; !ok {
+		t.Errorf("expected headshot to be captured on map values, got %v", players["4046"].Extra)
+	}
+
+	var members []*LeagueMember
+	if err := c.decode(endpointLeagueUsers, []byte(`[{"user_id": "1", "is_owner": true, "settings": null}]`), &members); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := members[0].Extra["user_id"]; ok || len(members[0].Extra) != 1 {
+		t.Errorf("expected only settings to be captured on embedded user, got %v", members[0].Extra)
+	}
+}
+
+func TestSchema_DriftHook(t *testing.T) {
+	type testSettings struct {
+		Wins int `json:"wins"`
+	}
+	type testRoster struct {
+		RosterID int           `json:"roster_id"`
+		OwnerID  string        `json:"owner_id"`
+		Settings *testSettings `json:"settings"`
+	}
+
+	var drifts []*SchemaDrift
+	c := &Client{schemaDriftHook: func(d *SchemaDrift) { drifts = append(drifts, d) }}
+
+	responses := []struct {
+		data       string
+		shouldPass bool
+	}{
+		{`[{"roster_id": 1, "owner_id": "a", "settings": {"wins": 1}}]`, true},
+		{`[{"roster_id": 1, "owner_id": null, "settings": {"wins": 1}}, {"roster_id": 2, "settings": null}]`, true},
+		{`[{"roster_id": "1", "settings": {"wins": 1}, "division": 2}]`, false},
+		{`[{"roster_id": "2", "settings": {"wins": 1}, "division": 1}]`, false},
+	}
+	for _, response := range responses {
+		var rosters []*testRoster
+		if err := c.decode(endpointLeagueRosters, []byte(response.data), &rosters); err != nil {
+			if response.shouldPass {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			t.Logf("expected error: %v", err)
+		}
+	}
+
+	if len(drifts) != 1 {
+		by, _ := json.Marshal(drifts)
+		t.Fatalf("expected 1 drift report, got %d: %s", len(drifts), by)
+	}
+
+	d := drifts[0]
+	if d.Endpoint != endpointLeagueRosters {
+		t.Errorf("expected endpoint %s, got %s", endpointLeagueRosters, d.Endpoint)
+	}
+	if !slices.Equal(d.Added, []string{"[].division"}) || !slices.Equal(d.Removed, []string{"[].owner_id"}) {
+		t.Errorf("unexpected drift report: %+v", d)
+	}
+	if len(d.Changed) != 1 || d.Changed[0].Path != "[].roster_id" || d.Changed[0].Previous != "number" || d.Changed[0].Current != "string" {
+		t.Errorf("unexpected type changes: %+v", d.Changed)
+	}
+}
+
+func TestSchema_DynamicFields(t *testing.T) {
+	var drifts []*SchemaDrift
+	c := &Client{captureUnknownFields: true, schemaDriftHook: func(d *SchemaDrift) { drifts = append(drifts, d) }}
+
+	var metadata *RosterMetadata
+	data := `{"allow_pn_news": "on", "allow_pn_scoring": "off", "allow_pn_inactive_starters": "on", "allow_pn_player_injury_status": "on", "restrict_pn_scoring_starters_only": "off", "record": "WWL", "streak": "1L", "p_nick_4046": "Nick", "new_flag": "on"}`
+	if err := c.decode(endpointLeagueRosters, []byte(data), &metadata); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if metadata.PlayerNicknames["4046"] != "Nick" {
+		t.Errorf("expected player nickname, got %v", metadata.PlayerNicknames)
+	}
+	if len(metadata.Extra) != 1 || string(metadata.Extra["new_flag"]) != `"on"` {
+		t.Errorf("expected only new_flag to be captured, got %v", metadata.Extra)
+	}
+	if len(drifts) != 1 || !slices.Equal(drifts[0].Added, []string{"new_flag"}) || len(drifts[0].Removed) != 0 {
+		by, _ := json.Marshal(drifts)
+		t.Errorf("expected new_flag to be reported as added, got %s", by)
+	}
+}
@@ -0,0 +1,252 @@
+package sleeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type seasonType string
+
+const (
+	SeasonTypePre     seasonType = "pre"
+	SeasonTypeRegular seasonType = "regular"
+	SeasonTypePost    seasonType = "post"
+	SeasonTypeOff     seasonType = "off"
+)
+
+const (
+	calendarWeek = 7 * 24 * time.Hour
+)
+
+// WeekRollover is the day and hour at which one scoring week ends and the next begins.
+type WeekRollover struct {
+	Day  time.Weekday
+	Hour int
+}
+
+// sportCalendar holds the per-sport calendar defaults.
+type sportCalendar struct {
+	regularSeasonWeeks int
+	postseasonWeeks    int
+	rollover           WeekRollover
+}
+
+// sportCalendars holds the calendar defaults for each sport. NFL weeks roll over on Tuesday once Monday's
+// games are final; the other sports score Monday to Sunday.
+var sportCalendars = map[sport]sportCalendar{
+	SportNFL: {regularSeasonWeeks: 18, postseasonWeeks: 5, rollover: WeekRollover{Day: time.Tuesday, Hour: 12}},
+	SportNBA: {regularSeasonWeeks: 25, postseasonWeeks: 9, rollover: WeekRollover{Day: time.Monday, Hour: 12}},
+	SportNHL: {regularSeasonWeeks: 26, postseasonWeeks: 9, rollover: WeekRollover{Day: time.Monday, Hour: 12}},
+	SportMLB: {regularSeasonWeeks: 26, postseasonWeeks: 5, rollover: WeekRollover{Day: time.Monday, Hour: 12}},
+}
+
+// CalendarOptions holds options for a SeasonCalendar. Zero values use the sport's defaults.
+type CalendarOptions struct {
+	RegularSeasonWeeks int            // Weeks in the regular season (default: 18 for NFL, 25 for NBA, 26 for NHL and MLB)
+	PostseasonWeeks    int            // Weeks of postseason after the regular season (default: 5 for NFL and MLB, 9 for NBA and NHL)
+	Rollover           *WeekRollover  // When weeks roll over (default: Tuesday 12:00 for NFL, Monday 12:00 otherwise)
+	Location           *time.Location // Location the start date and rollover are in (default: UTC)
+}
+
+func (o *CalendarOptions) validate() error {
+	var errs []string
+	if o.RegularSeasonWeeks < 0 {
+		errs = append(errs, "RegularSeasonWeeks must be greater than or equal to zero")
+	}
+	if o.PostseasonWeeks < 0 {
+		errs = append(errs, "PostseasonWeeks must be greater than or equal to zero")
+	}
+	if o.Rollover != nil {
+		if o.Rollover.Day < time.Sunday || o.Rollover.Day > time.Saturday {
+			errs = append(errs, "Rollover.Day must be a valid weekday")
+		}
+		if o.Rollover.Hour < 0 || o.Rollover.Hour > 23 {
+			errs = append(errs, "Rollover.Hour must be between 0 and 23")
+		}
+	}
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+// CalendarWeek identifies the week of a season that a point in time falls in.
+type CalendarWeek struct {
+	Season     string     `json:"season"`
+	SeasonType seasonType `json:"season_type"`
+	Week       int        `json:"week"` // 0 before the regular season and in the offseason; postseason weeks continue the regular season numbering
+}
+
+// SeasonCalendar maps points in time to the weeks of a sport's season.
+type SeasonCalendar struct {
+	Sport              sport
+	Season             string
+	Start              time.Time // start of week 1: the last rollover at or before the regular season start date
+	RegularSeasonWeeks int
+	PostseasonWeeks    int
+	Rollover           WeekRollover
+}
+
+// SeasonStart returns midnight UTC on the regular season start date.
+func (s *SportState) SeasonStart() (time.Time, error) {
+	return s.seasonStart(time.UTC)
+}
+
+// seasonStart returns midnight on the regular season start date in loc.
+func (s *SportState) seasonStart(loc *time.Location) (time.Time, error) {
+	if s.SeasonStartDate.IsZero() {
+		return time.Time{}, errors.New("season start date is not set")
+	}
+	date := s.SeasonStartDate.Time().UTC()
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, loc), nil
+}
+
+// NewSeasonCalendar creates a calendar for the season described by a sport state (see GetSportState).
+func NewSeasonCalendar(sport sport, state *SportState, options CalendarOptions) (*SeasonCalendar, error) {
+	if state == nil {
+		return nil, errors.New("sport state is required")
+	}
+	defaults, ok := sportCalendars[sport]
+	if !ok {
+		return nil, fmt.Errorf("unsupported sport: %s", sport)
+	}
+	if err := options.validate(); err != nil {
+		return nil, fmt.Errorf("invalid calendar options: %w", err)
+	}
+
+	if options.RegularSeasonWeeks == 0 {
+		options.RegularSeasonWeeks = defaults.regularSeasonWeeks
+	}
+	if options.PostseasonWeeks == 0 {
+		options.PostseasonWeeks = defaults.postseasonWeeks
+	}
+	if options.Rollover == nil {
+		options.Rollover = &defaults.rollover
+	}
+	if options.Location == nil {
+		options.Location = time.UTC
+	}
+
+	startDate, err := state.seasonStart(options.Location)
+	if err != nil {
+		return nil, err
+	}
+
+	// Step back from the start date to the most recent rollover so week 1 covers the opening games.
+	start := time.Date(startDate.Year(), startDate.Month(), startDate.Day(), options.Rollover.Hour, 0, 0, 0, options.Location)
+	start = start.AddDate(0, 0, -int((startDate.Weekday()-options.Rollover.Day+7)%7))
+	if start.After(startDate) {
+		start = start.AddDate(0, 0, -7)
+	}
+
+	return &SeasonCalendar{
+		Sport:              sport,
+		Season:             state.Season,
+		Start:              start,
+		RegularSeasonWeeks: options.RegularSeasonWeeks,
+		PostseasonWeeks:    options.PostseasonWeeks,
+		Rollover:           *options.Rollover,
+	}, nil
+}
+
+// WeekStart returns when a week begins.
+func (c *SeasonCalendar) WeekStart(week int) time.Time {
+	return c.Start.AddDate(0, 0, 7*(week-1))
+}
+
+// WeekEnd returns when a week ends, which is when the next week begins.
+func (c *SeasonCalendar) WeekEnd(week int) time.Time {
+	return c.WeekStart(week + 1)
+}
+
+// At returns the season type and week a point in time falls in.
+func (c *SeasonCalendar) At(t time.Time) CalendarWeek {
+	cw := CalendarWeek{Season: c.Season}
+	if t.Before(c.Start) {
+		cw.SeasonType = SeasonTypePre
+		return cw
+	}
+
+	// Walk forward from the start rather than dividing, as weeks crossing a DST change are not 168 hours.
+	week := int(t.Sub(c.Start)/calendarWeek) + 1
+	for week > 1 && t.Before(c.WeekStart(week)) {
+		week--
+	}
+	for !t.Before(c.WeekEnd(week)) {
+		week++
+	}
+
+	switch {
+	case week <= c.RegularSeasonWeeks:
+		cw.SeasonType = SeasonTypeRegular
+		cw.Week = week
+	case week <= c.RegularSeasonWeeks+c.PostseasonWeeks:
+		cw.SeasonType = SeasonTypePost
+		cw.Week = week
+	default:
+		cw.SeasonType = SeasonTypeOff
+	}
+	return cw
+}
+
+// WeekCompleted reports whether a week has rolled over by the given time.
+func (c *SeasonCalendar) WeekCompleted(week int, now time.Time) bool {
+	return !now.Before(c.WeekEnd(week))
+}
+
+// ScoringWeek returns the regular season week whose matchups are being scored at the given time:
+// week 1 before the season starts and the final regular season week once it has ended. It is derived from
+// dates alone; GetScoringWeek prefers the week reported by the sport state.
+func (c *SeasonCalendar) ScoringWeek(now time.Time) int {
+	cw := c.At(now)
+	switch cw.SeasonType {
+	case SeasonTypePre:
+		return 1
+	case SeasonTypeRegular:
+		return cw.Week
+	default:
+		return c.RegularSeasonWeeks
+	}
+}
+
+// GetSeasonCalendar retrieves a sport's state and creates a calendar for its current season.
+func (c *Client) GetSeasonCalendar(ctx context.Context, sport sport, options CalendarOptions) (*SeasonCalendar, error) {
+	state, err := c.GetSportState(ctx, sport)
+	if err != nil {
+		return nil, fmt.Errorf("getting season calendar: %w", err)
+	}
+
+	return NewSeasonCalendar(sport, state, options)
+}
+
+// GetScoringWeek returns the current scoring week for a sport. The week reported by the sport state is
+// used when set, clamped to the regular season; otherwise it is derived from the sport's default calendar.
+func (c *Client) GetScoringWeek(ctx context.Context, sport sport) (int, error) {
+	state, err := c.GetSportState(ctx, sport)
+	if err != nil {
+		return 0, fmt.Errorf("getting scoring week: %w", err)
+	}
+
+	return scoringWeek(sport, state, time.Now())
+}
+
+// scoringWeek returns the scoring week for a sport state, falling back to the calendar when the state
+// does not report a week.
+func scoringWeek(sport sport, state *SportState, now time.Time) (int, error) {
+	defaults, ok := sportCalendars[sport]
+	if !ok {
+		return 0, fmt.Errorf("unsupported sport: %s", sport)
+	}
+	if state.Week > 0 {
+		return min(state.Week, defaults.regularSeasonWeeks), nil
+	}
+
+	calendar, err := NewSeasonCalendar(sport, state, CalendarOptions{})
+	if err != nil {
+		return 0, err
+	}
+	return calendar.ScoringWeek(now), nil
+}
@@ -0,0 +1,235 @@
+package sleeper
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func testDate(date string) Timestamp {
+	d, err := time.Parse(timestampDateLayout, date)
+	if err != nil {
+		panic(err)
+	}
+	return NewTimestamp(d)
+}
+
+func TestSeasonCalendar_At(t *testing.T) {
+	var state SportState
+	if err := json.Unmarshal([]byte(`{"season": "2025", "season_start_date": "2025-09-04"}`), &state); err != nil { // a Thursday
+		t.Fatalf("unexpected error: %v", err)
+	}
+	calendar, err := NewSeasonCalendar(SportNFL, &state, CalendarOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if expected := time.Date(2025, time.September, 2, 12, 0, 0, 0, time.UTC); !calendar.Start.Equal(expected) {
+		t.Fatalf("expected week 1 to start %v, got %v", expected, calendar.Start)
+	}
+
+	tt := []struct {
+		testcase     string
+		at           time.Time
+		expectedType seasonType
+		expectedWeek int
+		scoringWeek  int
+	}{
+		{
+			"preseason",
+			time.Date(2025, time.August, 20, 0, 0, 0, 0, time.UTC),
+			SeasonTypePre,
+			0,
+			1,
+		},
+		{
+			"opening game",
+			time.Date(2025, time.September, 5, 0, 20, 0, 0, time.UTC),
+			SeasonTypeRegular,
+			1,
+			1,
+		},
+		{
+			"monday night before rollover",
+			time.Date(2025, time.September, 9, 3, 0, 0, 0, time.UTC),
+			SeasonTypeRegular,
+			1,
+			1,
+		},
+		{
+			"tuesday after rollover",
+			time.Date(2025, time.September, 9, 12, 0, 0, 0, time.UTC),
+			SeasonTypeRegular,
+			2,
+			2,
+		},
+		{
+			"postseason",
+			time.Date(2026, time.January, 14, 0, 0, 0, 0, time.UTC),
+			SeasonTypePost,
+			20,
+			18,
+		},
+		{
+			"offseason",
+			time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC),
+			SeasonTypeOff,
+			0,
+			18,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testcase, func(t *testing.T) {
+			cw := calendar.At(tc.at)
+			if cw.SeasonType != tc.expectedType || cw.Week != tc.expectedWeek {
+				t.Errorf("expected %s week %d, got %s week %d", tc.expectedType, tc.expectedWeek, cw.SeasonType, cw.Week)
+				return
+			}
+			if week := calendar.ScoringWeek(tc.at); week != tc.scoringWeek {
+				t.Errorf("expected scoring week %d, got %d", tc.scoringWeek, week)
+				return
+			}
+		})
+	}
+
+	if calendar.WeekCompleted(1, time.Date(2025, time.September, 9, 3, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected week 1 to be in progress")
+	}
+	if !calendar.WeekCompleted(1, time.Date(2025, time.September, 10, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected week 1 to be completed")
+	}
+}
+
+func TestSeasonCalendar_New(t *testing.T) {
+	tt := []struct {
+		testcase   string
+		sport      sport
+		state      *SportState
+		options    CalendarOptions
+		shouldPass bool
+	}{
+		{
+			"custom rollover",
+			SportNBA,
+			&SportState{Season: "2025", SeasonStartDate: testDate("2025-10-21")},
+			CalendarOptions{Rollover: &WeekRollover{Day: time.Sunday, Hour: 6}},
+			true,
+		},
+		{
+			"missing start date",
+			SportNFL,
+			&SportState{Season: "2025"},
+			CalendarOptions{},
+			false,
+		},
+		{
+			"invalid rollover hour",
+			SportNFL,
+			&SportState{Season: "2025", SeasonStartDate: testDate("2025-09-04")},
+			CalendarOptions{Rollover: &WeekRollover{Day: time.Tuesday, Hour: 24}},
+			false,
+		},
+		{
+			"unsupported sport",
+			"invalid_sport",
+			&SportState{Season: "2025", SeasonStartDate: testDate("2025-09-04")},
+			CalendarOptions{},
+			false,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testcase, func(t *testing.T) {
+			calendar, err := NewSeasonCalendar(tc.sport, tc.state, tc.options)
+			if err != nil {
+				if tc.shouldPass {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+				t.Logf("expected error: %v", err)
+				return
+			}
+
+			if !tc.shouldPass {
+				t.Errorf("expected failure but got calendar: %+v", calendar)
+				return
+			}
+
+			if calendar.Start.Weekday() != tc.options.Rollover.Day || calendar.Start.After(time.Date(2025, time.October, 21, 0, 0, 0, 0, time.UTC)) {
+				t.Errorf("unexpected week 1 start: %v", calendar.Start)
+			}
+		})
+	}
+}
+
+func TestScoringWeek(t *testing.T) {
+	now := time.Date(2025, time.October, 1, 0, 0, 0, 0, time.UTC) // week 5 by date
+
+	tt := []struct {
+		testcase     string
+		sport        sport
+		state        *SportState
+		expectedWeek int
+		shouldPass   bool
+	}{
+		{
+			"reported week",
+			SportNFL,
+			&SportState{Season: "2025", SeasonStartDate: testDate("2025-09-04"), Week: 7},
+			7,
+			true,
+		},
+		{
+			"reported postseason week clamped",
+			SportNFL,
+			&SportState{Season: "2025", SeasonStartDate: testDate("2025-09-04"), Week: 20},
+			18,
+			true,
+		},
+		{
+			"no reported week falls back to the calendar",
+			SportNFL,
+			&SportState{Season: "2025", SeasonStartDate: testDate("2025-09-04")},
+			5,
+			true,
+		},
+		{
+			"no reported week or start date",
+			SportNFL,
+			&SportState{Season: "2025"},
+			0,
+			false,
+		},
+		{
+			"unsupported sport",
+			"invalid_sport",
+			&SportState{Season: "2025", Week: 3},
+			0,
+			false,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testcase, func(t *testing.T) {
+			week, err := scoringWeek(tc.sport, tc.state, now)
+			if err != nil {
+				if tc.shouldPass {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+				t.Logf("expected error: %v", err)
+				return
+			}
+
+			if !tc.shouldPass {
+				t.Errorf("expected failure but got week: %d", week)
+				return
+			}
+
+			if week != tc.expectedWeek {
+				t.Errorf("expected week %d, got %d", tc.expectedWeek, week)
+			}
+		})
+	}
+}
@@ -17,15 +17,17 @@ const (
 )
 
 type SportState struct {
-	Week               int            `json:"week,omitempty"`              // week
-	SeasonType         string         `json:"season_type,omitempty"`       // pre, post, regular
-	SeasonStartDate    string         `json:"season_start_date,omitempty"` // regular season start
-	Season             string         `json:"season,omitempty"`            // current season
+	Week               int            `json:"week,omitempty"`             // week
+	SeasonType         string         `json:"season_type,omitempty"`      // pre, post, regular
+	SeasonStartDate    Timestamp      `json:"season_start_date,omitzero"` // regular season start date
+	Season             string         `json:"season,omitempty"`           // current season
 	PreviousSeason     FlexibleString `json:"previous_season,omitempty"`
 	Leg                int            `json:"leg,omitempty"`                  // week of regular season
 	LeagueSeason       string         `json:"league_season,omitempty"`        // active season for leagues
 	LeagueCreateSeason string         `json:"league_create_season,omitempty"` // flips in December
 	DisplayWeek        int            `json:"display_week,omitempty"`         // Which week to display in UI, can be different than week
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 func (c *Client) GetSportState(ctx context.Context, sport sport) (*SportState, error) {
@@ -41,7 +43,7 @@ func (c *Client) GetSportState(ctx context.Context, sport sport) (*SportState, e
 		return nil, fmt.Errorf("getting sport state: %w", err)
 	}
 
-	if err := json.Unmarshal(by, &state); err != nil {
+	if err := c.decode(endpointSportState, by, &state); err != nil {
 		return nil, fmt.Errorf("unmarshaling sport state: %w", err)
 	}
 
@@ -0,0 +1,223 @@
+package sleeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+type starterAlertType string
+
+const (
+	StarterAlertEmptySlot starterAlertType = "empty_slot"
+	StarterAlertOut       starterAlertType = "out"
+	StarterAlertDoubtful  starterAlertType = "doubtful"
+	StarterAlertReserve   starterAlertType = "injured_reserve"
+	StarterAlertInactive  starterAlertType = "inactive"
+	StarterAlertBye       starterAlertType = "bye"
+)
+
+const playerStatusActive = "Active"
+
+// StarterAlert flags a starting slot that is empty or filled by a player unlikely to play.
+type StarterAlert struct {
+	Type        starterAlertType `json:"type"`
+	RosterID    int              `json:"roster_id"`
+	OwnerID     string           `json:"owner_id,omitempty"`
+	Slot        string           `json:"slot"`
+	SlotIndex   int              `json:"slot_index"` // index into Roster.Starters
+	PlayerID    string           `json:"player_id,omitempty"`
+	Message     string           `json:"message"`
+	Replacement string           `json:"replacement,omitempty"` // suggested slot-eligible bench player_id
+}
+
+// RosterAlerts holds the starter alerts for a single roster.
+type RosterAlerts struct {
+	RosterID int             `json:"roster_id"`
+	OwnerID  string          `json:"owner_id,omitempty"`
+	Alerts   []*StarterAlert `json:"alerts"`
+}
+
+// StarterCheckOptions holds options for CheckStarters.
+type StarterCheckOptions struct {
+	ByeTeams map[string]bool // Teams on bye this week, keyed by abbreviation as in Player.Team
+}
+
+// CheckStarters cross-references each roster's starters with the player catalog (see ListNFLPlayers)
+// and returns alerts for empty slots and starters who are out, doubtful, on IR, inactive or on bye.
+// Each alert suggests a healthy, slot-eligible bench replacement when one exists. Only rosters with
+// alerts are returned.
+func CheckStarters(league *League, rosters []*Roster, players map[string]Player, options StarterCheckOptions) []*RosterAlerts {
+	if league == nil {
+		return nil
+	}
+
+	slots := league.StarterSlots()
+	var results []*RosterAlerts
+	for _, roster := range rosters {
+		if roster == nil {
+			continue
+		}
+
+		ra := &RosterAlerts{RosterID: roster.RosterID, OwnerID: roster.OwnerID}
+		bench := benchPlayers(roster, players)
+		used := make(map[string]bool)
+
+		for i, playerID := range roster.Starters {
+			slot := ""
+			if i < len(slots) {
+				slot = slots[i]
+			}
+
+			alert := starterAlert(playerID, players, options)
+			if alert == nil {
+				continue
+			}
+			alert.RosterID = roster.RosterID
+			alert.OwnerID = roster.OwnerID
+			alert.Slot = slot
+			alert.SlotIndex = i
+
+			for _, candidate := range bench {
+				if used[candidate] {
+					continue
+				}
+				if !SlotEligible(slot, players[candidate].FantasyPositions) || starterAlert(candidate, players, options) != nil {
+					continue
+				}
+				alert.Replacement = candidate
+				used[candidate] = true
+				break
+			}
+
+			ra.Alerts = append(ra.Alerts, alert)
+		}
+
+		if len(ra.Alerts) > 0 {
+			results = append(results, ra)
+		}
+	}
+
+	return results
+}
+
+// starterAlert returns an alert for a starter who is unlikely to play, or nil. Only the type, player and
+// message are set.
+func starterAlert(playerID string, players map[string]Player, options StarterCheckOptions) *StarterAlert {
+	if playerID == "" || playerID == emptyStarterID {
+		return &StarterAlert{Type: StarterAlertEmptySlot, Message: "starting slot is empty"}
+	}
+
+	player, ok := players[playerID]
+	if !ok {
+		return nil
+	}
+	name := playerName(player, playerID)
+
+	if player.InjuryStatus != nil {
+		switch *player.InjuryStatus {
+		case injuryStatusOut:
+			return &StarterAlert{Type: StarterAlertOut, PlayerID: playerID, Message: fmt.Sprintf("%s is ruled out", name)}
+		case injuryStatusDoubtful:
+			return &StarterAlert{Type: StarterAlertDoubtful, PlayerID: playerID, Message: fmt.Sprintf("%s is doubtful", name)}
+		case injuryStatusIR, injuryStatusPUP, injuryStatusSuspended, injuryStatusCOVID, injuryStatusNotActive, injuryStatusDNR:
+			return &StarterAlert{Type: StarterAlertReserve, PlayerID: playerID, Message: fmt.Sprintf("%s is inactive (%s)", name, *player.InjuryStatus)}
+		}
+	}
+
+	if player.Status != "" && player.Status != playerStatusActive {
+		return &StarterAlert{Type: StarterAlertInactive, PlayerID: playerID, Message: fmt.Sprintf("%s is not active (%s)", name, player.Status)}
+	}
+
+	if player.Team != nil && options.ByeTeams[*player.Team] {
+		return &StarterAlert{Type: StarterAlertBye, PlayerID: playerID, Message: fmt.Sprintf("%s (%s) is on bye", name, *player.Team)}
+	}
+
+	return nil
+}
+
+// benchPlayers returns a roster's bench players, excluding starters, reserve and taxi players, best ranked first.
+func benchPlayers(roster *Roster, players map[string]Player) []string {
+	var bench []string
+	for _, playerID := range roster.Players {
+		if slices.Contains(roster.Starters, playerID) || slices.Contains(roster.Reserve, playerID) || slices.Contains(roster.Taxi, playerID) {
+			continue
+		}
+		bench = append(bench, playerID)
+	}
+
+	// Sleeper's search rank orders players by overall value; unranked players sort last.
+	rank := func(playerID string) int {
+		if r := players[playerID].SearchRank; r > 0 {
+			return r.Int()
+		}
+		return int(^uint(0) >> 1)
+	}
+	sort.SliceStable(bench, func(i, j int) bool {
+		return rank(bench[i]) < rank(bench[j])
+	})
+	return bench
+}
+
+// GetStarterAlerts retrieves a league's rosters and the week's matchups and checks each roster's starters
+// for that week. The player catalog (see ListNFLPlayers) is required; byeTeams lists the teams on bye.
+func (c *Client) GetStarterAlerts(ctx context.Context, leagueID string, week int, players map[string]Player, byeTeams []string) ([]*RosterAlerts, error) {
+	var errs []string
+	leagueID = strings.TrimSpace(leagueID)
+	if leagueID == "" {
+		errs = append(errs, "leagueID is required")
+	}
+	if week < 1 {
+		errs = append(errs, "week must be greater than or equal to 1")
+	}
+	if len(players) == 0 {
+		errs = append(errs, "players is required")
+	}
+	if len(errs) > 0 {
+		return nil, errors.New(strings.Join(errs, "\n"))
+	}
+
+	league, err := c.GetLeague(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("getting starter alerts: %w", err)
+	}
+
+	rosters, err := c.GetLeagueRosters(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("getting starter alerts: %w", err)
+	}
+
+	matchups, err := c.GetLeagueMatchups(ctx, leagueID, week)
+	if err != nil {
+		return nil, fmt.Errorf("getting starter alerts: %w", err)
+	}
+
+	// Use the week's starters from the matchups when available, as rosters only hold the current lineup.
+	starters := make(map[int][]string)
+	for _, m := range matchups {
+		if m != nil && len(m.Starters) > 0 {
+			starters[m.RosterID] = m.Starters
+		}
+	}
+	weekly := make([]*Roster, 0, len(rosters))
+	for _, r := range rosters {
+		if r == nil {
+			continue
+		}
+		copied := *r
+		if s, ok := starters[r.RosterID]; ok {
+			copied.Starters = s
+		}
+		weekly = append(weekly, &copied)
+	}
+
+	byes := make(map[string]bool)
+	for _, team := range byeTeams {
+		byes[team] = true
+	}
+
+	return CheckStarters(league, weekly, players, StarterCheckOptions{ByeTeams: byes}), nil
+}
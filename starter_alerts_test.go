@@ -0,0 +1,71 @@
+package sleeper
+
+import (
+	"testing"
+)
+
+func TestStarterAlerts_Check(t *testing.T) {
+	league := &League{RosterPositions: []string{"QB", "RB", "WR", "FLEX", "BN", "BN", "BN"}}
+	players := map[string]Player{
+		"qb":       {FullName: "Bye Quarterback", FantasyPositions: []string{"QB"}, Team: strPtr("KC"), Status: "Active"},
+		"rb":       {FullName: "Out Back", FantasyPositions: []string{"RB"}, Team: strPtr("SF"), InjuryStatus: strPtr("Out")},
+		"wr":       {FullName: "Healthy Receiver", FantasyPositions: []string{"WR"}, Team: strPtr("SF"), Status: "Active"},
+		"bench_rb": {FullName: "Bench Back", FantasyPositions: []string{"RB"}, Team: strPtr("SF"), SearchRank: 50},
+		"bench_wr": {FullName: "Bench Receiver", FantasyPositions: []string{"WR"}, Team: strPtr("DAL"), SearchRank: 10},
+		"bench_qb": {FullName: "Bench Quarterback", FantasyPositions: []string{"QB"}, Team: strPtr("KC"), SearchRank: 5},
+	}
+
+	tt := []struct {
+		testcase             string
+		roster               *Roster
+		options              StarterCheckOptions
+		expectedAlerts       []starterAlertType
+		expectedReplacements []string
+	}{
+		{
+			"healthy lineup",
+			&Roster{RosterID: 1, Starters: []string{"wr", "wr", "wr", "wr"}},
+			StarterCheckOptions{},
+			nil,
+			nil,
+		},
+		{
+			"out, bye and empty slots",
+			&Roster{
+				RosterID: 2,
+				Starters: []string{"qb", "rb", "wr", "0"},
+				Players:  []string{"qb", "rb", "wr", "bench_rb", "bench_wr", "bench_qb"},
+			},
+			StarterCheckOptions{ByeTeams: map[string]bool{"KC": true}},
+			[]starterAlertType{StarterAlertBye, StarterAlertOut, StarterAlertEmptySlot},
+			// The backup QB is also on bye, so no replacement is suggested for the QB slot.
+			[]string{"", "bench_rb", "bench_wr"},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testcase, func(t *testing.T) {
+			results := CheckStarters(league, []*Roster{tc.roster}, players, tc.options)
+			if len(tc.expectedAlerts) == 0 {
+				if len(results) != 0 {
+					t.Errorf("expected no alerts, got %+v", results[0].Alerts)
+				}
+				return
+			}
+
+			if len(results) != 1 || len(results[0].Alerts) != len(tc.expectedAlerts) {
+				t.Errorf("expected %d alerts, got %+v", len(tc.expectedAlerts), results)
+				return
+			}
+
+			for i, alert := range results[0].Alerts {
+				if alert.Type != tc.expectedAlerts[i] {
+					t.Errorf("expected alert %s, got %s", tc.expectedAlerts[i], alert.Type)
+				}
+				if alert.Replacement != tc.expectedReplacements[i] {
+					t.Errorf("expected replacement %q for %s, got %q", tc.expectedReplacements[i], alert.Slot, alert.Replacement)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,447 @@
+package sleeper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type tradeAssetKind string
+
+const (
+	TradeAssetPlayer tradeAssetKind = "player"
+	TradeAssetPick   tradeAssetKind = "pick"
+	TradeAssetFAAB   tradeAssetKind = "faab"
+)
+
+const (
+	defaultTradeWeeks         = 18 // Number of transaction weeks scanned per season
+	transactionStatusComplete = "complete"
+	draftStatusComplete       = "complete"
+)
+
+// TradeAsset identifies a single asset that can change hands in a trade: a player, a draft pick or FAAB.
+type TradeAsset struct {
+	Kind             tradeAssetKind `json:"kind"`
+	PlayerID         string         `json:"player_id,omitempty"`          // player assets
+	Season           string         `json:"season,omitempty"`             // pick assets
+	Round            int            `json:"round,omitempty"`              // pick assets
+	OriginalRosterID int            `json:"original_roster_id,omitempty"` // pick assets, the roster the pick originally belonged to
+	Amount           int            `json:"amount,omitempty"`             // FAAB assets
+}
+
+// PlayerAsset returns the TradeAsset for a player.
+func PlayerAsset(playerID string) TradeAsset {
+	return TradeAsset{Kind: TradeAssetPlayer, PlayerID: playerID}
+}
+
+// PickAsset returns the TradeAsset for a draft pick, identified by season, round and the original owner's roster_id.
+func PickAsset(season string, round, originalRosterID int) TradeAsset {
+	return TradeAsset{Kind: TradeAssetPick, Season: season, Round: round, OriginalRosterID: originalRosterID}
+}
+
+// Key returns a stable identifier for the asset, e.g. "player:4034" or "pick:2025:1:3".
+// FAAB is fungible, so every FAAB asset shares the same key.
+func (a TradeAsset) Key() string {
+	switch a.Kind {
+	case TradeAssetPlayer:
+		return fmt.Sprintf("player:%s", a.PlayerID)
+	case TradeAssetPick:
+		return fmt.Sprintf("pick:%s:%d:%d", a.Season, a.Round, a.OriginalRosterID)
+	default:
+		return string(a.Kind)
+	}
+}
+
+// Label returns a human readable description of the asset, resolving player names from the catalog when possible.
+func (a TradeAsset) Label(players map[string]Player) string {
+	switch a.Kind {
+	case TradeAssetPlayer:
+		if p, ok := players[a.PlayerID]; ok && p.FullName != "" {
+			return p.FullName
+		}
+		return a.PlayerID
+	case TradeAssetPick:
+		return fmt.Sprintf("%s round %d pick (roster %d)", a.Season, a.Round, a.OriginalRosterID)
+	case TradeAssetFAAB:
+		return fmt.Sprintf("$%d FAAB", a.Amount)
+	default:
+		return string(a.Kind)
+	}
+}
+
+// TradeMove is a single asset moving between two rosters as part of a trade.
+type TradeMove struct {
+	Asset        TradeAsset `json:"asset"`
+	FromRosterID int        `json:"from_roster_id"`
+	ToRosterID   int        `json:"to_roster_id"`
+	Player       *Player    `json:"player,omitempty"` // resolved player for player assets, when a catalog is available
+}
+
+// Trade is a completed trade transaction with every asset movement resolved.
+type Trade struct {
+	TransactionID string       `json:"transaction_id"`
+	LeagueID      string       `json:"league_id"`
+	Season        string       `json:"season"`
+	Week          int          `json:"week"`
+	Created       Timestamp    `json:"created"`
+	RosterIDs     []int        `json:"roster_ids"`
+	Moves         []*TradeMove `json:"moves"`
+}
+
+// Received returns the moves in which the given roster received an asset.
+func (t *Trade) Received(rosterID int) []*TradeMove {
+	var moves []*TradeMove
+	for _, m := range t.Moves {
+		if m.ToRosterID == rosterID {
+			moves = append(moves, m)
+		}
+	}
+	return moves
+}
+
+// Sent returns the moves in which the given roster gave up an asset.
+func (t *Trade) Sent(rosterID int) []*TradeMove {
+	var moves []*TradeMove
+	for _, m := range t.Moves {
+		if m.FromRosterID == rosterID {
+			moves = append(moves, m)
+		}
+	}
+	return moves
+}
+
+// NewTrade converts a trade transaction into a Trade. Player assets are resolved from the optional players catalog.
+func NewTrade(tx *Transaction, leagueID, season string, players map[string]Player) (*Trade, error) {
+	if tx == nil {
+		return nil, errors.New("transaction is required")
+	}
+	if tx.Type != TransactionTypeTrade {
+		return nil, fmt.Errorf("transaction %s is not a trade", tx.TransactionID)
+	}
+
+	trade := &Trade{
+		TransactionID: tx.TransactionID,
+		LeagueID:      leagueID,
+		Season:        season,
+		Week:          tx.Leg,
+		Created:       tx.Created,
+		RosterIDs:     tx.RosterIDs,
+	}
+
+	// Adds map a player to the receiving roster, drops map the same player to the sending roster.
+	playerIDs := make([]string, 0, len(tx.Adds))
+	for playerID := range tx.Adds {
+		playerIDs = append(playerIDs, playerID)
+	}
+	sort.Strings(playerIDs)
+	for _, playerID := range playerIDs {
+		move := &TradeMove{
+			Asset:        PlayerAsset(playerID),
+			FromRosterID: tx.Drops[playerID],
+			ToRosterID:   tx.Adds[playerID],
+		}
+		if p, ok := players[playerID]; ok {
+			move.Player = &p
+		}
+		trade.Moves = append(trade.Moves, move)
+	}
+
+	for _, pick := range tx.DraftPicks {
+		if pick == nil {
+			continue
+		}
+		trade.Moves = append(trade.Moves, &TradeMove{
+			Asset:        PickAsset(pick.Season, pick.Round, pick.RosterID),
+			FromRosterID: pick.PreviousOwnerID,
+			ToRosterID:   pick.OwnerID,
+		})
+	}
+
+	for _, budget := range tx.WaiverBudget {
+		if budget == nil {
+			continue
+		}
+		trade.Moves = append(trade.Moves, &TradeMove{
+			Asset:        TradeAsset{Kind: TradeAssetFAAB, Amount: budget.Amount},
+			FromRosterID: budget.Sender,
+			ToRosterID:   budget.Receiver,
+		})
+	}
+
+	return trade, nil
+}
+
+// TradeHistoryOptions holds options for loading a league's trade history.
+type TradeHistoryOptions struct {
+	Weeks   int               // Number of transaction weeks to scan per season (default: 18)
+	Players map[string]Player // Optional player catalog (see ListNFLPlayers) used to resolve players
+}
+
+// TradeHistory holds every trade across a league's history in chronological order.
+type TradeHistory struct {
+	Trades  []*Trade          `json:"trades"`
+	Players map[string]Player `json:"-"`
+
+	// Selections maps a pick asset key to the player_id that was drafted with it,
+	// allowing trade trees to follow a pick into the player it became.
+	Selections map[string]string `json:"selections,omitempty"`
+}
+
+// NewTradeHistory creates a TradeHistory from a set of trades, sorting them chronologically.
+func NewTradeHistory(trades []*Trade, players map[string]Player) *TradeHistory {
+	sorted := make([]*Trade, 0, len(trades))
+	for _, t := range trades {
+		if t != nil {
+			sorted = append(sorted, t)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Created.Time().Before(sorted[j].Created.Time())
+	})
+
+	return &TradeHistory{
+		Trades:     sorted,
+		Players:    players,
+		Selections: make(map[string]string),
+	}
+}
+
+// AddDraftSelections records which player was drafted with each pick of a completed draft.
+func (h *TradeHistory) AddDraftSelections(draft *Draft, picks []*DraftPick) {
+	if draft == nil {
+		return
+	}
+	if h.Selections == nil {
+		h.Selections = make(map[string]string)
+	}
+
+	for _, pick := range picks {
+		if pick == nil || pick.PlayerID == "" {
+			continue
+		}
+		original, ok := draft.SlotToRosterID[strconv.Itoa(pick.DraftSlot)]
+		if !ok {
+			continue
+		}
+		h.Selections[PickAsset(draft.Season, pick.Round, original).Key()] = pick.PlayerID
+	}
+}
+
+// TradesFor returns every trade that moved the given asset, in chronological order.
+func (h *TradeHistory) TradesFor(asset TradeAsset) []*Trade {
+	var trades []*Trade
+	for _, t := range h.Trades {
+		for _, m := range t.Moves {
+			if m.Asset.Key() == asset.Key() {
+				trades = append(trades, t)
+				break
+			}
+		}
+	}
+	return trades
+}
+
+// TradeTreeNode is a node in a trade tree. It describes an asset held by a roster and,
+// if the asset was later traded away, everything the roster received for it.
+type TradeTreeNode struct {
+	Asset         TradeAsset       `json:"asset"`
+	Label         string           `json:"label"`
+	RosterID      int              `json:"roster_id"`                // roster holding the asset
+	TransactionID string           `json:"transaction_id,omitempty"` // trade in which the asset left the roster
+	Season        string           `json:"season,omitempty"`
+	Week          int              `json:"week,omitempty"`
+	TradedTo      int              `json:"traded_to,omitempty"`
+	DraftedAs     *TradeTreeNode   `json:"drafted_as,omitempty"` // for picks, the player selected with the pick
+	Received      []*TradeTreeNode `json:"received,omitempty"`   // assets received in exchange
+}
+
+// Tree builds the trade tree for an asset, starting with the first trade that moved it and following
+// everything received in return through subsequent trades.
+func (h *TradeHistory) Tree(asset TradeAsset) (*TradeTreeNode, error) {
+	for i, t := range h.Trades {
+		for _, m := range t.Moves {
+			if m.Asset.Key() == asset.Key() {
+				return h.follow(m.Asset, m.FromRosterID, i), nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("no trades found for %s", asset.Key())
+}
+
+// follow builds the node for an asset held by rosterID, looking for trades at or after index start.
+func (h *TradeHistory) follow(asset TradeAsset, rosterID int, start int) *TradeTreeNode {
+	node := &TradeTreeNode{
+		Asset:    asset,
+		Label:    asset.Label(h.Players),
+		RosterID: rosterID,
+	}
+
+	// FAAB is fungible and cannot be followed further.
+	if asset.Kind == TradeAssetFAAB {
+		return node
+	}
+
+	for i := start; i < len(h.Trades); i++ {
+		t := h.Trades[i]
+		for _, m := range t.Moves {
+			if m.Asset.Key() != asset.Key() || m.FromRosterID != rosterID {
+				continue
+			}
+
+			node.TransactionID = t.TransactionID
+			node.Season = t.Season
+			node.Week = t.Week
+			node.TradedTo = m.ToRosterID
+			for _, r := range t.Received(rosterID) {
+				node.Received = append(node.Received, h.follow(r.Asset, rosterID, i+1))
+			}
+			return node
+		}
+	}
+
+	// The asset was never traded away again; a pick may still have turned into a player.
+	if asset.Kind == TradeAssetPick {
+		if playerID, ok := h.Selections[asset.Key()]; ok {
+			node.DraftedAs = h.follow(PlayerAsset(playerID), rosterID, h.lastTradeIndex(asset)+1)
+		}
+	}
+
+	return node
+}
+
+// lastTradeIndex returns the index of the last trade that moved the asset, or -1.
+func (h *TradeHistory) lastTradeIndex(asset TradeAsset) int {
+	last := -1
+	for i, t := range h.Trades {
+		for _, m := range t.Moves {
+			if m.Asset.Key() == asset.Key() {
+				last = i
+			}
+		}
+	}
+	return last
+}
+
+// JSON returns the trade tree encoded as indented JSON.
+func (n *TradeTreeNode) JSON() ([]byte, error) {
+	return json.MarshalIndent(n, "", "  ")
+}
+
+// DOT returns the trade tree as a Graphviz DOT digraph.
+func (n *TradeTreeNode) DOT() string {
+	var sb strings.Builder
+	sb.WriteString("digraph trade_tree {\n")
+	sb.WriteString("  rankdir=LR;\n")
+	sb.WriteString("  node [shape=box];\n")
+
+	id := 0
+	var walk func(node *TradeTreeNode) int
+	walk = func(node *TradeTreeNode) int {
+		self := id
+		id++
+		fmt.Fprintf(&sb, "  n%d [label=%q];\n", self, fmt.Sprintf("%s\nroster %d", node.Label, node.RosterID))
+
+		if node.DraftedAs != nil {
+			child := walk(node.DraftedAs)
+			fmt.Fprintf(&sb, "  n%d -> n%d [label=%q];\n", self, child, "drafted")
+		}
+		for _, r := range node.Received {
+			child := walk(r)
+			edge := fmt.Sprintf("%s wk %d to roster %d", node.Season, node.Week, node.TradedTo)
+			fmt.Fprintf(&sb, "  n%d -> n%d [label=%q];\n", self, child, edge)
+		}
+		return self
+	}
+	walk(n)
+
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// GetTradeHistory retrieves every completed trade across all seasons of a league's history, and the
+// selections made with traded picks in completed drafts so trade trees can follow picks into players.
+func (c *Client) GetTradeHistory(ctx context.Context, leagueID string, options TradeHistoryOptions) (*TradeHistory, error) {
+	if options.Weeks < 0 {
+		return nil, errors.New("Weeks must be greater than or equal to zero")
+	}
+	if options.Weeks == 0 {
+		options.Weeks = defaultTradeWeeks
+	}
+
+	leagues, err := c.GetLeagueHistory(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("getting trade history: %w", err)
+	}
+
+	trades, err := c.getHistoryTrades(ctx, leagues, options.Weeks, options.Players)
+	if err != nil {
+		return nil, fmt.Errorf("getting trade history: %w", err)
+	}
+
+	history := NewTradeHistory(trades, options.Players)
+	for _, league := range leagues {
+		if league.DraftID == "" {
+			continue
+		}
+
+		draft, err := c.GetDraft(ctx, league.DraftID)
+		if err != nil {
+			return nil, fmt.Errorf("getting trade history: %w", err)
+		}
+		if draft == nil || draft.Status != draftStatusComplete {
+			continue
+		}
+
+		picks, err := c.GetDraftPicks(ctx, draft.DraftID)
+		if err != nil {
+			return nil, fmt.Errorf("getting trade history: %w", err)
+		}
+		history.AddDraftSelections(draft, picks)
+	}
+
+	return history, nil
+}
+
+// getHistoryTrades retrieves the completed trades for every season of a league's history.
+func (c *Client) getHistoryTrades(ctx context.Context, leagues []*League, weeks int, players map[string]Player) ([]*Trade, error) {
+	var trades []*Trade
+	for _, league := range leagues {
+		seasonTrades, err := c.getSeasonTrades(ctx, league, weeks, players)
+		if err != nil {
+			return nil, err
+		}
+		trades = append(trades, seasonTrades...)
+	}
+
+	return trades, nil
+}
+
+// getSeasonTrades retrieves the completed trades for a single league season.
+func (c *Client) getSeasonTrades(ctx context.Context, league *League, weeks int, players map[string]Player) ([]*Trade, error) {
+	var trades []*Trade
+	for week := 1; week <= weeks; week++ {
+		transactions, err := c.GetTransactions(ctx, league.LeagueID, week)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tx := range transactions {
+			if tx == nil || tx.Type != TransactionTypeTrade || tx.Status != transactionStatusComplete {
+				continue
+			}
+			trade, err := NewTrade(tx, league.LeagueID, league.Season, players)
+			if err != nil {
+				return nil, err
+			}
+			trades = append(trades, trade)
+		}
+	}
+
+	return trades, nil
+}
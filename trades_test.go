@@ -0,0 +1,208 @@
+package sleeper
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testTradeHistory() *TradeHistory {
+	// Roster 1 trades player 100 to roster 2 for a 2024 1st and FAAB,
+	// then flips that 1st to roster 3 for player 300. The pick becomes player 400.
+	txs := []*Transaction{
+		{
+			Type:          TransactionTypeTrade,
+			TransactionID: "t1",
+			Status:        "complete",
+			Leg:           3,
+			Created:       NewTimestamp(time.UnixMilli(1000)),
+			RosterIDs:     []int{1, 2},
+			Adds:          map[string]int{"100": 2},
+			Drops:         map[string]int{"100": 1},
+			DraftPicks: []*TradedDraftPick{
+				{Season: "2024", Round: 1, RosterID: 2, PreviousOwnerID: 2, OwnerID: 1},
+			},
+			WaiverBudget: []*WaiverBudget{{Sender: 2, Receiver: 1, Amount: 15}},
+		},
+		{
+			Type:          TransactionTypeTrade,
+			TransactionID: "t2",
+			Status:        "complete",
+			Leg:           8,
+			Created:       NewTimestamp(time.UnixMilli(2000)),
+			RosterIDs:     []int{1, 3},
+			Adds:          map[string]int{"300": 1},
+			Drops:         map[string]int{"300": 3},
+			DraftPicks: []*TradedDraftPick{
+				{Season: "2024", Round: 1, RosterID: 2, PreviousOwnerID: 1, OwnerID: 3},
+			},
+		},
+	}
+
+	players := map[string]Player{"100": {FullName: "Player One"}}
+
+	var trades []*Trade
+	for _, tx := range txs {
+		trade, err := NewTrade(tx, "league", "2023", players)
+		if err != nil {
+			panic(err)
+		}
+		trades = append(trades, trade)
+	}
+
+	// Return trades out of order to exercise sorting.
+	history := NewTradeHistory([]*Trade{trades[1], trades[0]}, players)
+	history.AddDraftSelections(&Draft{
+		Season:         "2024",
+		SlotToRosterID: map[string]int{"1": 3, "2": 2},
+	}, []*DraftPick{
+		{Round: 1, DraftSlot: 2, PlayerID: "400"},
+	})
+	return history
+}
+
+func TestTrade_New(t *testing.T) {
+	tt := []struct {
+		testcase      string
+		transaction   *Transaction
+		expectedMoves int
+		shouldPass    bool
+	}{
+		{
+			"trade with players, picks and faab",
+			&Transaction{
+				Type:         TransactionTypeTrade,
+				Adds:         map[string]int{"1": 2, "2": 1},
+				Drops:        map[string]int{"1": 1, "2": 2},
+				DraftPicks:   []*TradedDraftPick{{Season: "2025", Round: 2, RosterID: 1, PreviousOwnerID: 1, OwnerID: 2}},
+				WaiverBudget: []*WaiverBudget{{Sender: 1, Receiver: 2, Amount: 10}},
+			},
+			4,
+			true,
+		},
+		{
+			"waiver transaction",
+			&Transaction{Type: TransactionTypeWaiver},
+			0,
+			false,
+		},
+		{
+			"missing transaction",
+			nil,
+			0,
+			false,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testcase, func(t *testing.T) {
+			trade, err := NewTrade(tc.transaction, "league", "2024", nil)
+			if err != nil {
+				if tc.shouldPass {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+				t.Logf("expected error: %v", err)
+				return
+			}
+
+			if !tc.shouldPass {
+				t.Errorf("expected failure but got trade: %v", trade)
+				return
+			}
+
+			if len(trade.Moves) != tc.expectedMoves {
+				t.Errorf("expected %d moves, got %d", tc.expectedMoves, len(trade.Moves))
+				return
+			}
+		})
+	}
+}
+
+func TestTrade_Tree(t *testing.T) {
+	history := testTradeHistory()
+
+	tt := []struct {
+		testcase       string
+		asset          TradeAsset
+		expectedTrades int
+		shouldPass     bool
+	}{
+		{
+			"player traded once",
+			PlayerAsset("100"),
+			1,
+			true,
+		},
+		{
+			"pick traded twice",
+			PickAsset("2024", 1, 2),
+			2,
+			true,
+		},
+		{
+			"asset never traded",
+			PlayerAsset("999"),
+			0,
+			false,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testcase, func(t *testing.T) {
+			if got := len(history.TradesFor(tc.asset)); got != tc.expectedTrades {
+				t.Errorf("expected %d trades, got %d", tc.expectedTrades, got)
+				return
+			}
+
+			tree, err := history.Tree(tc.asset)
+			if err != nil {
+				if tc.shouldPass {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+				t.Logf("expected error: %v", err)
+				return
+			}
+
+			if !tc.shouldPass {
+				t.Errorf("expected failure but got tree: %v", tree)
+				return
+			}
+
+			if _, err := json.Marshal(tree); err != nil {
+				t.Errorf("unexpected error marshaling tree: %v", err)
+				return
+			}
+
+			if !strings.HasPrefix(tree.DOT(), "digraph trade_tree {") {
+				t.Errorf("expected DOT digraph output")
+				return
+			}
+		})
+	}
+
+	// Follow player 100 forward: roster 1 received the pick and FAAB, and flipped the pick for player 300.
+	tree, err := history.Tree(PlayerAsset("100"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tree.Label != "Player One" || tree.TradedTo != 2 || len(tree.Received) != 2 {
+		t.Fatalf("unexpected root node: %+v", tree)
+	}
+
+	pick := tree.Received[0]
+	if pick.Asset.Kind != TradeAssetPick || pick.TransactionID != "t2" || len(pick.Received) != 1 {
+		t.Fatalf("unexpected pick node: %+v", pick)
+	}
+	if pick.Received[0].Asset.PlayerID != "300" {
+		t.Errorf("expected pick to be flipped for player 300, got %s", pick.Received[0].Asset.Key())
+	}
+
+	// Roster 3 kept the pick and drafted player 400 with it.
+	kept := history.follow(PickAsset("2024", 1, 2), 3, 0)
+	if kept.DraftedAs == nil || kept.DraftedAs.Asset.PlayerID != "400" {
+		t.Errorf("expected pick to be drafted as player 400, got %+v", kept.DraftedAs)
+	}
+}
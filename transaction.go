@@ -1,5 +1,7 @@
 package sleeper
 
+import "encoding/json"
+
 type transactionType string
 
 const (
@@ -12,7 +14,7 @@ const (
 type Transaction struct {
 	Type          transactionType      `json:"type,omitempty"`
 	TransactionID string               `json:"transaction_id,omitempty"`
-	StatusUpdated int64                `json:"status_updated,omitempty"`
+	StatusUpdated Timestamp            `json:"status_updated,omitzero"`
 	Status        string               `json:"status,omitempty"`
 	Settings      *TransactionSettings `json:"settings,omitempty"`   // trades do not use this field
 	RosterIDs     []int                `json:"roster_ids,omitempty"` // roster_ids involved in this transaction
@@ -21,20 +23,26 @@ type Transaction struct {
 	Drops         map[string]int       `json:"drops,omitempty"`
 	DraftPicks    []*TradedDraftPick   `json:"draft_picks,omitempty"` // picks that were traded
 	Creator       string               `json:"creator,omitempty"`     // user id who initiated the transaction
-	Created       int64                `json:"created,omitempty"`
+	Created       Timestamp            `json:"created,omitzero"`
 	ConsenterIDs  []int                `json:"consenter_ids,omitempty"` // roster_ids of the people who agreed to this transaction
 	Adds          map[string]int       `json:"adds,omitempty"`
 	WaiverBudget  []*WaiverBudget      `json:"waiver_budget,omitempty"` // roster_id 2 sends 55 FAAB dollars to roster_id 3
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // TransactionSettings holds settings for waiver transactions
 type TransactionSettings struct {
 	WaiverBid int `json:"waiver_bid,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // TransactionMetadata can contain notes about why a transaction didn't go through
 type TransactionMetadata struct {
 	Notes string `json:"notes,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // TradedDraftPick represents a draft pick that was traded
@@ -44,6 +52,8 @@ type TradedDraftPick struct {
 	RosterID        int    `json:"roster_id,omitempty"`         // original owner's roster_id
 	PreviousOwnerID int    `json:"previous_owner_id,omitempty"` // previous owner's roster id (in this trade)
 	OwnerID         int    `json:"owner_id,omitempty"`          // the new owner of this pick after the trade
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // WaiverBudget represents a waiver amount involved in a trade
@@ -51,4 +61,6 @@ type WaiverBudget struct {
 	Sender   int `json:"sender,omitempty"`
 	Receiver int `json:"receiver,omitempty"`
 	Amount   int `json:"amount,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
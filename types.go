@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
 )
 
 // FlexibleString can unmarshal from either a string or int
@@ -40,3 +42,243 @@ func (f FlexibleString) Int() (int, error) {
 	}
 	return i, nil
 }
+
+type timestampFormat int
+
+const (
+	timestampMillis       timestampFormat = iota // JSON number of milliseconds since the epoch
+	timestampMillisString                        // JSON string of milliseconds since the epoch
+	timestampDate                                // JSON string date, e.g. "2024-09-01"
+	timestampRFC3339                             // JSON string RFC 3339 time
+)
+
+const timestampDateLayout = "2006-01-02"
+
+// Timestamp can unmarshal a point in time from any of the forms Sleeper uses: milliseconds since the epoch
+// as a number or string, a date string or an RFC 3339 string. It marshals back to the form it was
+// decoded from; a Timestamp created with NewTimestamp marshals as milliseconds, and a zero Timestamp as null.
+type Timestamp struct {
+	time   time.Time
+	format timestampFormat
+	raw    string // JSON the value was decoded from
+}
+
+// NewTimestamp returns a Timestamp for t that marshals as milliseconds since the epoch.
+func NewTimestamp(t time.Time) Timestamp {
+	return Timestamp{time: t}
+}
+
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	parsed := Timestamp{raw: string(data)}
+
+	// Try number
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err == nil {
+		ms, err := n.Int64()
+		if err != nil {
+			f, err := n.Float64()
+			if err != nil {
+				return fmt.Errorf("cannot unmarshal %s into Timestamp", data)
+			}
+			ms = int64(f)
+		}
+		if ms != 0 {
+			parsed.time = time.UnixMilli(ms)
+		}
+		*t = parsed
+		return nil
+	}
+
+	// Try string
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("cannot unmarshal %s into Timestamp", data)
+	}
+	s = strings.TrimSpace(s)
+	if s == "" {
+		*t = parsed
+		return nil
+	}
+
+	if ms, err := strconv.ParseInt(s, 10, 64); err == nil {
+		parsed.format = timestampMillisString
+		if ms != 0 {
+			parsed.time = time.UnixMilli(ms)
+		}
+	} else if d, err := time.Parse(timestampDateLayout, s); err == nil {
+		parsed.format = timestampDate
+		parsed.time = d
+	} else if rfc, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		parsed.format = timestampRFC3339
+		parsed.time = rfc
+	} else {
+		return fmt.Errorf("cannot unmarshal %q into Timestamp", s)
+	}
+
+	*t = parsed
+	return nil
+}
+
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	if t.raw != "" {
+		return []byte(t.raw), nil
+	}
+	if t.time.IsZero() {
+		return []byte("null"), nil
+	}
+
+	switch t.format {
+	case timestampMillisString:
+		return json.Marshal(strconv.FormatInt(t.time.UnixMilli(), 10))
+	case timestampDate:
+		return json.Marshal(t.time.Format(timestampDateLayout))
+	case timestampRFC3339:
+		return json.Marshal(t.time.Format(time.RFC3339Nano))
+	default:
+		return []byte(strconv.FormatInt(t.time.UnixMilli(), 10)), nil
+	}
+}
+
+// Time returns the time.Time value; dates are midnight UTC.
+func (t Timestamp) Time() time.Time {
+	return t.time
+}
+
+// UnixMilli returns the time in milliseconds since the epoch, or zero if the Timestamp is zero.
+func (t Timestamp) UnixMilli() int64 {
+	if t.time.IsZero() {
+		return 0
+	}
+	return t.time.UnixMilli()
+}
+
+// IsZero reports whether the Timestamp is unset. Sleeper's null, 0 and "" are all zero.
+func (t Timestamp) IsZero() bool {
+	return t.time.IsZero()
+}
+
+// String returns the time formatted as RFC 3339, or an empty string if the Timestamp is zero.
+func (t Timestamp) String() string {
+	if t.time.IsZero() {
+		return ""
+	}
+	return t.time.Format(time.RFC3339)
+}
+
+// FlexInt can unmarshal from a JSON number (floats are truncated), a numeric string, an empty string or a bool.
+// Non-numeric strings decode as zero.
+type FlexInt int
+
+func (f *FlexInt) UnmarshalJSON(data []byte) error {
+	var v FlexFloat
+	if err := v.UnmarshalJSON(data); err != nil {
+		return fmt.Errorf("cannot unmarshal %s into FlexInt", data)
+	}
+	if string(data) != "null" {
+		*f = FlexInt(v)
+	}
+	return nil
+}
+
+// Int returns the int value
+func (f FlexInt) Int() int {
+	return int(f)
+}
+
+// FlexFloat can unmarshal from a JSON number, a numeric string, an empty string or a bool. Non-numeric strings
+// decode as zero rather than failing the whole response; the change of JSON type is still reported as
+// schema drift when Config.SchemaDriftHook is set.
+type FlexFloat float64
+
+func (f *FlexFloat) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	// Try number
+	var n float64
+	if err := json.Unmarshal(data, &n); err == nil {
+		*f = FlexFloat(n)
+		return nil
+	}
+
+	// Try string
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			*f = 0
+			return nil
+		}
+		n, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			n = 0
+		}
+		*f = FlexFloat(n)
+		return nil
+	}
+
+	// Try bool
+	var b bool
+	if err := json.Unmarshal(data, &b); err == nil {
+		*f = 0
+		if b {
+			*f = 1
+		}
+		return nil
+	}
+
+	return fmt.Errorf("cannot unmarshal %s into FlexFloat", data)
+}
+
+// Float64 returns the float64 value
+func (f FlexFloat) Float64() float64 {
+	return float64(f)
+}
+
+// FlexBool can unmarshal from a JSON bool, a number (non-zero is true) or a string. The strings "on", "1",
+// "true", "yes", "y" and "t" are true, ignoring case; any other string is false.
+type FlexBool bool
+
+func (f *FlexBool) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	// Try bool
+	var b bool
+	if err := json.Unmarshal(data, &b); err == nil {
+		*f = FlexBool(b)
+		return nil
+	}
+
+	// Try number
+	var n float64
+	if err := json.Unmarshal(data, &n); err == nil {
+		*f = n != 0
+		return nil
+	}
+
+	// Try string
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		switch strings.ToLower(strings.TrimSpace(s)) {
+		case "on", "1", "true", "yes", "y", "t":
+			*f = true
+		default:
+			*f = false
+		}
+		return nil
+	}
+
+	return fmt.Errorf("cannot unmarshal %s into FlexBool", data)
+}
+
+// Bool returns the bool value
+func (f FlexBool) Bool() bool {
+	return bool(f)
+}
@@ -0,0 +1,193 @@
+package sleeper
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestTimestamp_JSON(t *testing.T) {
+	tt := []struct {
+		testcase     string
+		data         string
+		expectedTime time.Time
+		shouldPass   bool
+	}{
+		{
+			"milliseconds",
+			`1725148800000`,
+			time.Date(2024, time.September, 1, 0, 0, 0, 0, time.UTC),
+			true,
+		},
+		{
+			"milliseconds string",
+			`"1725148800000"`,
+			time.Date(2024, time.September, 1, 0, 0, 0, 0, time.UTC),
+			true,
+		},
+		{
+			"date string",
+			`"2024-09-01"`,
+			time.Date(2024, time.September, 1, 0, 0, 0, 0, time.UTC),
+			true,
+		},
+		{
+			"rfc3339 string",
+			`"2024-09-01T00:00:00.000Z"`,
+			time.Date(2024, time.September, 1, 0, 0, 0, 0, time.UTC),
+			true,
+		},
+		{
+			"zero",
+			`0`,
+			time.Time{},
+			true,
+		},
+		{
+			"null",
+			`null`,
+			time.Time{},
+			true,
+		},
+		{
+			"invalid string",
+			`"yesterday"`,
+			time.Time{},
+			false,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testcase, func(t *testing.T) {
+			var ts Timestamp
+			if err := json.Unmarshal([]byte(tc.data), &ts); err != nil {
+				if tc.shouldPass {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+				t.Logf("expected error: %v", err)
+				return
+			}
+
+			if !tc.shouldPass {
+				t.Errorf("expected failure but got timestamp: %v", ts)
+				return
+			}
+
+			if !ts.Time().Equal(tc.expectedTime) {
+				t.Errorf("expected time %v, got %v", tc.expectedTime, ts.Time())
+				return
+			}
+
+			by, err := json.Marshal(ts)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if string(by) != tc.data {
+				t.Errorf("expected %s to marshal back identically, got %s", tc.data, by)
+				return
+			}
+		})
+	}
+
+	by, err := json.Marshal(struct {
+		Created Timestamp `json:"created"`
+		Updated Timestamp `json:"updated,omitzero"`
+	}{Created: NewTimestamp(time.UnixMilli(1725148800000))})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(by) != `{"created":1725148800000}` {
+		t.Errorf("unexpected marshaled timestamps: %s", by)
+	}
+}
+
+func TestFlexTypes_Unmarshal(t *testing.T) {
+	tt := []struct {
+		testcase      string
+		data          string
+		expectedInt   FlexInt
+		expectedFloat FlexFloat
+		expectedBool  FlexBool
+		shouldPass    bool
+	}{
+		{"int", `1`, 1, 1, true, true},
+		{"float", `12.5`, 12, 12.5, true, true},
+		{"numeric string", `"1"`, 1, 1, true, true},
+		{"zero string", `"0"`, 0, 0, false, true},
+		{"empty string", `""`, 0, 0, false, true},
+		{"bool", `true`, 1, 1, true, true},
+		{"null", `null`, 0, 0, false, true},
+		{"invalid string", `"abc"`, 0, 0, false, true},
+		{"object", `{}`, 0, 0, false, false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testcase, func(t *testing.T) {
+			var i FlexInt
+			var f FlexFloat
+			errInt := json.Unmarshal([]byte(tc.data), &i)
+			errFloat := json.Unmarshal([]byte(tc.data), &f)
+			if errInt != nil || errFloat != nil {
+				if tc.shouldPass {
+					t.Errorf("unexpected error: %v, %v", errInt, errFloat)
+					return
+				}
+				t.Logf("expected error: %v", errInt)
+				return
+			}
+
+			if !tc.shouldPass {
+				t.Errorf("expected failure but got %v, %v", i, f)
+				return
+			}
+
+			var b FlexBool
+			if err := json.Unmarshal([]byte(tc.data), &b); err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+
+			if i != tc.expectedInt || f != tc.expectedFloat || b != tc.expectedBool {
+				t.Errorf("expected %v, %v, %v, got %v, %v, %v", tc.expectedInt, tc.expectedFloat, tc.expectedBool, i, f, b)
+				return
+			}
+		})
+	}
+}
+
+func TestFlexBool_Unmarshal(t *testing.T) {
+	tt := []struct {
+		data     string
+		expected FlexBool
+	}{
+		{`"on"`, true},
+		{`"off"`, false},
+		{`"1"`, true},
+		{`"false"`, false},
+		{`false`, false},
+		{`"maybe"`, false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.data, func(t *testing.T) {
+			var b FlexBool
+			if err := json.Unmarshal([]byte(tc.data), &b); err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if b != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, b)
+			}
+		})
+	}
+
+	var metadata RosterMetadata
+	if err := json.Unmarshal([]byte(`{"allow_pn_news": "on", "allow_pn_scoring": "off", "p_nick_123": "Nick"}`), &metadata); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !metadata.AllowPnNews || metadata.AllowPnScoring || metadata.PlayerNicknames["123"] != "Nick" {
+		t.Errorf("unexpected roster metadata: %+v", metadata)
+	}
+}
@@ -15,6 +15,61 @@ type User struct {
 	IsBot       bool   `json:"is_bot"`
 	UserID      string `json:"user_id"`
 	Username    string `json:"username"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// LeagueMember is a User as returned for a league, with the member's team settings for that league.
+type LeagueMember struct {
+	User
+	LeagueID string                `json:"league_id,omitempty"`
+	IsOwner  FlexBool              `json:"is_owner"` // the member is a commissioner of the league
+	Metadata *LeagueMemberMetadata `json:"metadata,omitempty"`
+}
+
+// LeagueMemberMetadata contains a member's team name, team avatar and notification preferences for a league.
+type LeagueMemberMetadata struct {
+	TeamName             string   `json:"team_name,omitempty"`
+	Avatar               string   `json:"avatar,omitempty"` // full URL of a custom team avatar
+	AllowPn              FlexBool `json:"allow_pn,omitempty"`
+	AllowSMS             FlexBool `json:"allow_sms,omitempty"`
+	MentionPn            FlexBool `json:"mention_pn,omitempty"`
+	LeagueReportPn       FlexBool `json:"league_report_pn,omitempty"`
+	PlayerLikePn         FlexBool `json:"player_like_pn,omitempty"`
+	PlayerNicknameUpdate FlexBool `json:"player_nickname_update,omitempty"`
+	TeamNameUpdate       FlexBool `json:"team_name_update,omitempty"`
+	TradeBlockPn         FlexBool `json:"trade_block_pn,omitempty"`
+	TransactionFreeAgent FlexBool `json:"transaction_free_agent,omitempty"`
+	TransactionTrades    FlexBool `json:"transaction_trades,omitempty"`
+	TransactionWaiver    FlexBool `json:"transaction_waiver,omitempty"`
+	UserMessagePn        FlexBool `json:"user_message_pn,omitempty"`
+	ArchivedTeamNames    []string `json:"archived_team_names,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// TeamName returns the member's team name, falling back to their display name and then username.
+func (m *LeagueMember) TeamName() string {
+	if m.Metadata != nil && strings.TrimSpace(m.Metadata.TeamName) != "" {
+		return m.Metadata.TeamName
+	}
+	if m.DisplayName != "" {
+		return m.DisplayName
+	}
+	return m.Username
+}
+
+// TeamAvatarURL returns the URL of the member's custom team avatar, or an empty string if they have none.
+func (m *LeagueMember) TeamAvatarURL() string {
+	if m.Metadata == nil {
+		return ""
+	}
+	return m.Metadata.Avatar
+}
+
+// IsCommissioner reports whether the member is a commissioner of the league.
+func (m *LeagueMember) IsCommissioner() bool {
+	return m.IsOwner.Bool()
 }
 
 // GetUser retrieves a User by identity (username or user_id of the user).
@@ -32,7 +87,7 @@ func (c *Client) GetUser(ctx context.Context, identity string) (*User, error) {
 		return nil, fmt.Errorf("getting user: %w", err)
 	}
 
-	if err := json.Unmarshal(by, &user); err != nil {
+	if err := c.decode(endpointUser, by, &user); err != nil {
 		return nil, fmt.Errorf("unmarshaling user: %w", err)
 	}
 
@@ -69,7 +124,7 @@ func (c *Client) GetUserLeagues(ctx context.Context, userID string, sport sport,
 		return nil, fmt.Errorf("getting leagues for user: %w", err)
 	}
 
-	if err := json.Unmarshal(by, &leagues); err != nil {
+	if err := c.decode(endpointUserLeagues, by, &leagues); err != nil {
 		return nil, fmt.Errorf("unmarshaling leagues: %w", err)
 	}
 
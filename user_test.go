@@ -2,6 +2,7 @@ package sleeper
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 )
 
@@ -122,3 +123,58 @@ func TestUser_GetLeagues(t *testing.T) {
 		})
 	}
 }
+
+func TestUser_LeagueMember(t *testing.T) {
+	tt := []struct {
+		testcase             string
+		data                 string
+		expectedTeamName     string
+		expectedAvatarURL    string
+		expectedCommissioner bool
+	}{
+		{
+			"team name and avatar",
+			`{"user_id": "1", "username": "taco", "display_name": "Taco", "is_owner": true, "metadata": {"team_name": "Team Taco", "avatar": "https://sleepercdn.com/uploads/taco.jpg", "mention_pn": "on"}}`,
+			"Team Taco",
+			"https://sleepercdn.com/uploads/taco.jpg",
+			true,
+		},
+		{
+			"no team name",
+			`{"user_id": "2", "username": "burrito", "display_name": "Burrito", "is_owner": null, "metadata": {"allow_pn": "off"}}`,
+			"Burrito",
+			"",
+			false,
+		},
+		{
+			"no metadata",
+			`{"user_id": "3", "username": "nacho"}`,
+			"nacho",
+			"",
+			false,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testcase, func(t *testing.T) {
+			var member LeagueMember
+			if err := json.Unmarshal([]byte(tc.data), &member); err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+
+			if member.UserID == "" {
+				t.Errorf("expected embedded user fields to be decoded")
+			}
+			if name := member.TeamName(); name != tc.expectedTeamName {
+				t.Errorf("expected team name %q, got %q", tc.expectedTeamName, name)
+			}
+			if url := member.TeamAvatarURL(); url != tc.expectedAvatarURL {
+				t.Errorf("expected avatar URL %q, got %q", tc.expectedAvatarURL, url)
+			}
+			if member.IsCommissioner() != tc.expectedCommissioner {
+				t.Errorf("expected commissioner %v, got %v", tc.expectedCommissioner, member.IsCommissioner())
+			}
+		})
+	}
+}